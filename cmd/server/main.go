@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"errors"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -13,33 +14,64 @@ import (
 	"syscall"
 	"time"
 
+	"subscription/core/domain"
 	"subscription/core/usecase"
 	ogenServer "subscription/internal/api/generated"
+	"subscription/internal/auth"
+	graphqlAdapter "subscription/internal/handler/graphql"
+	grpcAdapter "subscription/internal/handler/grpc"
 	ogenAdapter "subscription/internal/handler/ogen"
 	"subscription/internal/logger"
+	"subscription/internal/metrics"
 	"subscription/internal/repository/postgres"
+	"subscription/internal/tracing"
 
 	"github.com/rs/zerolog/log"
 )
 
 func main() {
-	if err := config.Load(); err != nil {
+	cfg, err := config.Load()
+	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to load config")
 	}
 
 	// Инициализация логгера
-	if err := logger.InitSimple(config.LogLevel); err != nil {
+	if err = logger.Init(logger.Config{
+		Level:      cfg.Log.Level,
+		Output:     cfg.Log.Format,
+		TimeFormat: time.RFC3339,
+		Caller:     true,
+		Color:      true,
+	}); err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize logger")
 	}
 
+	// Tracing
+	tracerProvider, err := tracing.NewProvider(context.Background(), tracing.Config{
+		ServiceName:  cfg.Tracing.ServiceName,
+		Exporter:     cfg.Tracing.Exporter,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: cfg.Tracing.OTLPInsecure,
+		FilePath:     cfg.Tracing.File,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize tracing")
+	}
+
 	// Подключение к БД
 	dbConfig := postgres.Params{
-		Host:     config.DBHost,
-		Port:     config.DBPort,
-		User:     config.DBUser,
-		Password: config.DBPassword,
-		Name:     config.DBName,
-		SSLMode:  config.SSLMode,
+		Host:            cfg.Database.Host,
+		Port:            cfg.Database.Port,
+		User:            cfg.Database.User,
+		Password:        cfg.Database.Password,
+		Name:            cfg.Database.Name,
+		SSLMode:         cfg.Database.SSLMode,
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		ConnMaxIdleTime: cfg.Database.ConnMaxIdleTime,
+		LegacyTenant:    cfg.Database.LegacyTenantID(),
 	}
 
 	dbClient, err := postgres.NewClient(dbConfig)
@@ -50,55 +82,175 @@ func main() {
 	defer dbClient.Close()
 
 	// Migrations
-	if err = dbClient.Migrate(&model.Subscription{}); err != nil {
+	if err = dbClient.Migrate(model.All()...); err != nil {
 		logger.Fatal().Err(err).Msg("Failed to run migrations")
 	}
 
+	// Connection pool metrics
+	if sqlDB, dbErr := dbClient.DB.DB(); dbErr != nil {
+		logger.Fatal().Err(dbErr).Msg("Failed to get underlying SQL DB")
+	} else if err = metrics.RegisterDBStats(sqlDB, cfg.Database.Name); err != nil {
+		logger.Fatal().Err(err).Msg("Failed to register database metrics")
+	}
+
 	// Repository
 	repoAdapter := postgres.NewSubscriptionRepository(dbClient.DB)
+	apiKeyRepo := postgres.NewAPIKeyRepository(dbClient.DB)
+	catalogRepo := postgres.NewCatalogRepository(dbClient.DB)
+	idempotencyStore := postgres.NewIdempotencyRepository(dbClient.DB)
 
 	// Сервис (ядро)
-	subscriptionService := usecase.NewSubscriptionService(repoAdapter)
+	subscriptionService := usecase.NewSubscriptionService(repoAdapter, catalogRepo, domain.CatalogMode(cfg.Catalog.Mode))
+	apiKeyService := usecase.NewAPIKeyService(apiKeyRepo)
+	catalogService := usecase.NewCatalogService(catalogRepo)
+
+	// JWT validation
+	tokenValidator, err := auth.NewValidator(auth.Config{
+		HS256Secret:         cfg.JWT.Secret,
+		JWKSFile:            cfg.JWT.JWKSFile,
+		JWKSURL:             cfg.JWT.JWKSURL,
+		Issuer:              cfg.JWT.Issuer,
+		Audience:            cfg.JWT.Audience,
+		RolesClaim:          cfg.JWT.RolesClaim,
+		TenantClaim:         cfg.JWT.TenantClaim,
+		JWKSRefreshInterval: cfg.JWT.JWKSRefreshInterval,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize token validator")
+	}
+	authenticator := auth.NewAuthenticator(tokenValidator, apiKeyService)
 
 	// Ogen httpAdapter
-	httpAdapter := ogenAdapter.NewOgenAdapter(subscriptionService)
+	httpAdapter := ogenAdapter.NewOgenAdapter(subscriptionService, apiKeyService, catalogService)
+	securityHandler := ogenAdapter.NewSecurityHandler(authenticator)
 
 	// Create ogen server.
-	server, err := ogenServer.NewServer(httpAdapter)
+	server, err := ogenServer.NewServer(httpAdapter, securityHandler,
+		ogenServer.WithTracerProvider(tracerProvider),
+		ogenServer.WithErrorHandler(ogenAdapter.ErrorHandler),
+		ogenServer.WithNotFound(ogenAdapter.NotFound),
+		ogenServer.WithMethodNotAllowed(ogenAdapter.MethodNotAllowed),
+	)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Failed to create ogen server")
 	}
 
+	// Per-client rate limiting
+	rateLimit := handler.RateLimitConfig{
+		IP:         handler.RateLimitPolicy{Rate: cfg.RateLimit.IPRPS, Burst: cfg.RateLimit.IPBurst},
+		Default:    handler.RateLimitPolicy{Rate: cfg.RateLimit.RPS, Burst: cfg.RateLimit.Burst},
+		MaxClients: cfg.RateLimit.MaxClients,
+	}
+	for _, route := range cfg.RateLimit.Routes {
+		rateLimit.Routes = append(rateLimit.Routes, handler.RateLimitPolicy{
+			Method:     route.Method,
+			PathPrefix: route.PathPrefix,
+			Rate:       route.RPS,
+			Burst:      route.Burst,
+		})
+	}
+
+	// GraphQL, the event stream and the database stats are served through the same middleware chain as the REST API
+	mounts := []handler.Mount{{
+		Path:    "/admin/db-stats",
+		Name:    "AdminDBStatsGet",
+		Handler: handler.DBStatsHandler(dbClient),
+	}, {
+		Path: "/subscriptions/events",
+		Name: "SubscriptionsEventsGet",
+		Handler: handler.SubscriptionEventsHandler(subscriptionService, handler.EventStreamConfig{
+			PollInterval:      cfg.Events.PollInterval,
+			HeartbeatInterval: cfg.Events.HeartbeatInterval,
+			WriteTimeout:      cfg.Server.WriteTimeout,
+		}),
+	}}
+	if cfg.GraphQL.Enabled {
+		mounts = append(mounts, handler.Mount{
+			Path: "/graphql",
+			Name: "graphql",
+			Handler: graphqlAdapter.NewHandler(subscriptionService, graphqlAdapter.Config{
+				MaxDepth:      cfg.GraphQL.MaxDepth,
+				MaxComplexity: cfg.GraphQL.MaxComplexity,
+			}),
+		})
+	}
+
 	// Add middlewares
-	httpHandler := handler.AddMiddleware(server)
+	httpHandler := handler.AddMiddleware(server, authenticator, idempotencyStore, handler.MiddlewareConfig{
+		RateLimit:      rateLimit,
+		IdempotencyTTL: cfg.Idempotency.TTL,
+		Mounts:         mounts,
+	})
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", handler.HealthCheckHandler)
 	mux.HandleFunc("/live", handler.LiveCheckHandler)
 	mux.HandleFunc("/ready", handler.ReadyCheckHandler(dbClient))
-	mux.HandleFunc("/admin/db-stats", handler.DBStatsHandler(dbClient))
+
 	mux.Handle("/", httpHandler)
 
 	// Create HTTP server with timeouts
 	srv := &http.Server{
-		Addr:         config.ServerHost + ":" + config.ServerPort,
+		Addr:         cfg.Server.Addr(),
 		Handler:      mux,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
 	}
 
+	// Metrics aggregate all tenants, they are served on an internal port only
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsSrv := &http.Server{
+		Addr:              net.JoinHostPort(cfg.Server.Host, cfg.Metrics.Port),
+		Handler:           metricsMux,
+		ReadHeaderTimeout: cfg.Server.ReadTimeout,
+	}
+
+	// gRPC server on a separate port
+	grpcServer := grpcAdapter.NewServer(subscriptionService, authenticator, rateLimit, tracerProvider)
+
+	// Background jobs stop together with the server
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	go handler.RunIdempotencyJanitor(jobsCtx, idempotencyStore, cfg.Idempotency.CleanupInterval)
+	go handler.RunSubscriptionEventJanitor(jobsCtx, repoAdapter, cfg.Events.Retention, cfg.Events.CleanupInterval)
+	go metrics.RunSubscriptionStats(jobsCtx, repoAdapter, cfg.Metrics.RefreshInterval)
+
 	// Channel for graceful shutdown
-	shutdownChan := make(chan error, 1)
+	shutdownChan := make(chan error, 3)
 
 	// Start server in goroutine
 	go func() {
-		logger.Info().Str("address", srv.Addr).Msgf("Starting server on %s:%s", config.ServerHost, config.ServerPort)
+		logger.Info().Str("address", srv.Addr).Msgf("Starting server on %s", srv.Addr)
 		if err = srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			shutdownChan <- err
 		}
 	}()
 
+	go func() {
+		logger.Info().Str("address", metricsSrv.Addr).Msgf("Starting metrics server on %s", metricsSrv.Addr)
+		if serveErr := metricsSrv.ListenAndServe(); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			shutdownChan <- serveErr
+		}
+	}()
+
+	if cfg.GRPC.Enabled {
+		grpcAddr := net.JoinHostPort(cfg.Server.Host, cfg.GRPC.Port)
+		listener, listenErr := net.Listen("tcp", grpcAddr)
+		if listenErr != nil {
+			logger.Fatal().Err(listenErr).Str("address", grpcAddr).Msg("Failed to listen for gRPC")
+		}
+
+		go func() {
+			logger.Info().Str("address", grpcAddr).Msgf("Starting gRPC server on %s", grpcAddr)
+			if serveErr := grpcServer.Serve(listener); serveErr != nil {
+				shutdownChan <- serveErr
+			}
+		}()
+	}
+
 	// Канал для системных сигналов
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
@@ -111,8 +263,10 @@ func main() {
 		logger.Error().Err(err).Msg("Server error occurred")
 	}
 
+	stopJobs()
+
 	// Graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 
 	logger.Info().Msg("Shutting down server gracefully...")
@@ -123,6 +277,26 @@ func main() {
 	} else {
 		logger.Info().Msg("Server stopped gracefully")
 	}
+	if err = metricsSrv.Shutdown(ctx); err != nil {
+		logger.Error().Err(err).Msg("Failed to shutdown metrics server")
+	}
+
+	// In-flight gRPC calls get the rest of the shutdown timeout
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+
+	// Flush pending spans
+	if err = tracerProvider.Shutdown(ctx); err != nil {
+		logger.Error().Err(err).Msg("Failed to shutdown tracer provider")
+	}
 
 	// Проверяем есть ли еще ошибки
 	select {
@@ -0,0 +1,580 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/repository/postgres/model"
+)
+
+// listPageSize is the largest page the service returns
+const listPageSize = 100
+
+func newFlagSet(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: subctl %s %s\n\nFlags:\n", name, synopsis)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses args and rejects positional arguments
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(fs.Output(), "unexpected arguments: %s\n", strings.Join(fs.Args(), " "))
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+// require reports missing required flags
+func require(fs *flag.FlagSet, values map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if values[name] == "" {
+			fmt.Fprintf(fs.Output(), "flag -%s is required\n", name)
+			fs.Usage()
+			return errUsage
+		}
+	}
+	return nil
+}
+
+func runList(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("list", "[-user IDS] [-service NAMES] [-from MM-YYYY [-to MM-YYYY]] [-status STATUSES] [-category NAMES] [-tag TAGS] [-page N -limit N | -all]")
+	users := fs.String("user", "", "comma-separated user `IDs`")
+	services := fs.String("service", "", "comma-separated service `names`")
+	from := fs.String("from", "", "start date lower bound `MM-YYYY`")
+	to := fs.String("to", "", "start date upper bound `MM-YYYY`, requires -from")
+	statuses := fs.String("status", "", "comma-separated `statuses` in the current month: trial, active, paused, cancellation_scheduled, ended")
+	categories := fs.String("category", "", "comma-separated `categories`")
+	tags := fs.String("tag", "", "comma-separated `tags`, subscriptions with any of them match")
+	page := fs.Int("page", 1, "page number")
+	limit := fs.Int("limit", 20, "page size, at most 100")
+	all := fs.Bool("all", false, "fetch all pages")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	filter, err := buildFilter(*users, *services, *from, *to)
+	if err != nil {
+		return err
+	}
+	for _, status := range splitList(*statuses) {
+		filter.Statuses = append(filter.Statuses, domain.Status(status))
+	}
+	filter.Categories = splitList(*categories)
+	filter.Tags = splitList(*tags)
+
+	if *all {
+		subscriptions, err := listAll(ctx, a.service, filter)
+		if err != nil {
+			return err
+		}
+		return a.printSubscriptions(subscriptions, nil)
+	}
+
+	subscriptions, meta, err := a.service.ListSubscriptions(ctx, filter, ports.Pagination{Page: *page, Limit: *limit})
+	if err != nil {
+		return err
+	}
+	return a.printSubscriptions(subscriptions, meta)
+}
+
+func runCreate(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("create", "-user ID -service NAME [-price AMOUNT [-currency CODE]] [-tax-rate BP [-tax-exclusive]] -start MM-YYYY [-end MM-YYYY] [-trial-end MM-YYYY] [-category NAME] [-tags TAGS] [-split RULE] [-members ID[:SHARE],...] [-discounts KIND:VALUE:START[:END],...]")
+	user := fs.String("user", "", "user `ID`")
+	service := fs.String("service", "", "service `name`")
+	price := fs.String("price", "", "monthly price as decimal `amount`, e.g. 7.99, defaults to the price of the catalog entry")
+	currency := fs.String("currency", string(domain.DefaultCurrency), "ISO 4217 `code` of the price")
+	taxRate := fs.Int("tax-rate", 0, "VAT rate in basis `points`, 2000 is 20%")
+	taxExclusive := fs.Bool("tax-exclusive", false, "the tax is added on top of the price instead of included in it")
+	start := fs.String("start", "", "start date `MM-YYYY`")
+	end := fs.String("end", "", "optional end date `MM-YYYY`")
+	trialEnd := fs.String("trial-end", "", "optional last month of the free trial `MM-YYYY`")
+	category := fs.String("category", "", "optional `category`, defaults to the category of the catalog entry")
+	tags := fs.String("tags", "", "optional comma-separated `tags`")
+	split := fs.String("split", "", "split `rule` of the members: equal, percentage or fixed, defaults to equal")
+	members := fs.String("members", "", "optional comma-separated `members` sharing the price as ID or ID:SHARE, fixed shares in minor units")
+	discounts := fs.String("discounts", "", "optional comma-separated `discounts` as KIND:VALUE:START[:END], e.g. percentage:50:01-2025:03-2025, fixed values in minor units")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"user": *user, "service": *service, "start": *start}); err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(*user)
+	if err != nil {
+		return fmt.Errorf("invalid user ID %q", *user)
+	}
+	monthlyPrice := domain.NewMoney(0, domain.Currency(*currency))
+	if *price != "" {
+		if monthlyPrice, err = domain.ParseMoney(*price, domain.Currency(*currency)); err != nil {
+			return fmt.Errorf("invalid price %q: %w", *price, err)
+		}
+	}
+	taxInclusive := !*taxExclusive
+	memberShares, err := parseMembers(*members)
+	if err != nil {
+		return err
+	}
+	discountRules, err := parseDiscounts(*discounts)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := a.service.CreateSubscription(ctx, &ports.CreateSubscriptionRequest{
+		UserID:       userID,
+		ServiceName:  *service,
+		Price:        monthlyPrice,
+		TaxRate:      *taxRate,
+		TaxInclusive: &taxInclusive,
+		StartDate:    *start,
+		EndDate:      optionalString(*end),
+		TrialEndDate: optionalString(*trialEnd),
+		Category:     *category,
+		Tags:         splitList(*tags),
+		Split:        domain.SplitRule(*split),
+		Members:      memberShares,
+		Discounts:    discountRules,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.printSubscription(subscription)
+}
+
+func runTrials(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("trials", "[-within N] [-user IDS] [-service NAMES] [-page N -limit N]")
+	within := fs.Int("within", 1, "months to look ahead, 1 is the current month only")
+	users := fs.String("user", "", "comma-separated user `IDs`")
+	services := fs.String("service", "", "comma-separated service `names`")
+	page := fs.Int("page", 1, "page number")
+	limit := fs.Int("limit", 20, "page size, at most 100")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	filter, err := buildFilter(*users, *services, "", "")
+	if err != nil {
+		return err
+	}
+
+	subscriptions, meta, err := a.service.ListEndingTrials(ctx, *within, filter, ports.Pagination{Page: *page, Limit: *limit})
+	if err != nil {
+		return err
+	}
+	return a.printSubscriptions(subscriptions, meta)
+}
+
+func runEnd(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("end", "-id ID -date MM-YYYY")
+	id := fs.String("id", "", "subscription `ID`")
+	date := fs.String("date", "", "end date `MM-YYYY`")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"id": *id, "date": *date}); err != nil {
+		return err
+	}
+
+	subscriptionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %q", *id)
+	}
+
+	subscription, err := a.service.PartialUpdateSubscription(ctx, subscriptionID, &ports.PartialUpdateRequest{EndDate: date})
+	if err != nil {
+		return err
+	}
+
+	return a.printSubscription(subscription)
+}
+
+func runPause(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("pause", "-id ID [-from MM-YYYY] [-to MM-YYYY]")
+	id := fs.String("id", "", "subscription `ID`")
+	from := fs.String("from", "", "first paused month `MM-YYYY`, defaults to the current month")
+	to := fs.String("to", "", "last paused month `MM-YYYY`, paused until resumed when omitted")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"id": *id}); err != nil {
+		return err
+	}
+
+	subscriptionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %q", *id)
+	}
+
+	req := &ports.PauseSubscriptionRequest{StartDate: *from}
+	if *to != "" {
+		req.EndDate = to
+	}
+
+	subscription, err := a.service.PauseSubscription(ctx, subscriptionID, req)
+	if err != nil {
+		return err
+	}
+
+	return a.printSubscription(subscription)
+}
+
+func runResume(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("resume", "-id ID [-date MM-YYYY]")
+	id := fs.String("id", "", "subscription `ID`")
+	date := fs.String("date", "", "first month charged again `MM-YYYY`, defaults to the current month")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"id": *id}); err != nil {
+		return err
+	}
+
+	subscriptionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %q", *id)
+	}
+
+	subscription, err := a.service.ResumeSubscription(ctx, subscriptionID, &ports.ResumeSubscriptionRequest{Date: *date})
+	if err != nil {
+		return err
+	}
+
+	return a.printSubscription(subscription)
+}
+
+func runCancel(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("cancel", "-id ID [-date MM-YYYY] [-reason TEXT]")
+	id := fs.String("id", "", "subscription `ID`")
+	date := fs.String("date", "", "last charged month `MM-YYYY`, defaults to the current month")
+	reason := fs.String("reason", "", "cancellation `reason`")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"id": *id}); err != nil {
+		return err
+	}
+
+	subscriptionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %q", *id)
+	}
+
+	subscription, err := a.service.CancelSubscription(ctx, subscriptionID, &ports.CancelSubscriptionRequest{
+		EndDate: *date,
+		Reason:  optionalString(*reason),
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.printSubscription(subscription)
+}
+
+func runDelete(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("delete", "-id ID")
+	id := fs.String("id", "", "subscription `ID`")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"id": *id}); err != nil {
+		return err
+	}
+
+	subscriptionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %q", *id)
+	}
+
+	if err = a.service.DeleteSubscription(ctx, subscriptionID); err != nil {
+		return err
+	}
+
+	return a.printResult(map[string]string{"deleted": subscriptionID.String()})
+}
+
+func runTotalCost(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("total-cost", "-from MM-YYYY -to MM-YYYY [-user IDS] [-service NAMES] [-category NAMES] [-tag TAGS] [-by category|tag] [-attribution share|payer] [-currency CODE]")
+	from := fs.String("from", "", "period start `MM-YYYY`")
+	to := fs.String("to", "", "period end `MM-YYYY`")
+	users := fs.String("user", "", "comma-separated user `IDs`")
+	services := fs.String("service", "", "comma-separated service `names`")
+	categories := fs.String("category", "", "comma-separated `categories`")
+	tags := fs.String("tag", "", "comma-separated `tags`, subscriptions with any of them are included")
+	groupBy := fs.String("by", "", "break the total down by `category` or tag")
+	attribution := fs.String("attribution", "", "charge the users their `share` of shared subscriptions or the payer the full price")
+	currency := fs.String("currency", "", "ISO 4217 `code` of the subscriptions to sum up, defaults to RUB")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"from": *from, "to": *to}); err != nil {
+		return err
+	}
+
+	userIDs, err := parseUUIDList(*users)
+	if err != nil {
+		return err
+	}
+
+	result, err := a.service.GetTotalCost(ctx, &ports.TotalCostRequest{
+		StartDate:    *from,
+		EndDate:      *to,
+		UserIDs:      userIDs,
+		ServiceNames: splitList(*services),
+		Categories:   splitList(*categories),
+		Tags:         splitList(*tags),
+		GroupBy:      ports.CostGroup(*groupBy),
+		Attribution:  ports.CostAttribution(*attribution),
+		Currency:     domain.Currency(*currency),
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.printTotalCost(result)
+}
+
+func runExport(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("export", "[-file PATH] [-user IDS] [-service NAMES] [-from MM-YYYY [-to MM-YYYY]]")
+	file := fs.String("file", "", "output `path`, stdout if empty")
+	users := fs.String("user", "", "comma-separated user `IDs`")
+	services := fs.String("service", "", "comma-separated service `names`")
+	from := fs.String("from", "", "start date lower bound `MM-YYYY`")
+	to := fs.String("to", "", "start date upper bound `MM-YYYY`, requires -from")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	filter, err := buildFilter(*users, *services, *from, *to)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := listAll(ctx, a.service, filter)
+	if err != nil {
+		return err
+	}
+
+	out := a.out
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err = writeCSV(out, subscriptions); err != nil {
+		return err
+	}
+
+	if *file != "" {
+		fmt.Fprintf(os.Stderr, "exported %d subscriptions to %s\n", len(subscriptions), *file)
+	}
+	return nil
+}
+
+func runImport(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("import", "-file PATH [-dry-run]")
+	file := fs.String("file", "", "CSV `path`, - for stdin")
+	dryRun := fs.Bool("dry-run", false, "validate rows without creating subscriptions")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := require(fs, map[string]string{"file": *file}); err != nil {
+		return err
+	}
+
+	in := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	requests, err := readCSV(in)
+	if err != nil {
+		return err
+	}
+
+	result := importResult{DryRun: *dryRun}
+	for _, row := range requests {
+		if row.err == nil && !*dryRun {
+			_, row.err = a.service.CreateSubscription(ctx, row.request)
+		} else if row.err == nil {
+			row.err = checkRequest(row.request)
+		}
+
+		if row.err != nil {
+			result.Failed = append(result.Failed, importFailure{Line: row.line, Error: row.err.Error()})
+			continue
+		}
+		result.Imported++
+	}
+
+	if err = a.printImport(result); err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("%d of %d rows failed", len(result.Failed), len(requests))
+	}
+	return nil
+}
+
+func runMigrate(_ context.Context, a *app, args []string) error {
+	fs := newFlagSet("migrate", "")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := a.client.Migrate(model.All()...); err != nil {
+		return err
+	}
+
+	return a.printResult(map[string]string{"migrated": "ok"})
+}
+
+// listAll fetches every page of the filtered subscriptions
+func listAll(ctx context.Context, service ports.SubscriptionService, filter ports.SubscriptionFilter) ([]*domain.Subscription, error) {
+	var all []*domain.Subscription
+
+	for page := 1; ; page++ {
+		subscriptions, meta, err := service.ListSubscriptions(ctx, filter, ports.Pagination{Page: page, Limit: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, subscriptions...)
+		if page >= meta.TotalPages {
+			return all, nil
+		}
+	}
+}
+
+func buildFilter(users, services, from, to string) (ports.SubscriptionFilter, error) {
+	userIDs, err := parseUUIDList(users)
+	if err != nil {
+		return ports.SubscriptionFilter{}, err
+	}
+
+	if to != "" && from == "" {
+		return ports.SubscriptionFilter{}, fmt.Errorf("-to requires -from")
+	}
+
+	return ports.SubscriptionFilter{
+		UserIDs:       userIDs,
+		ServiceNames:  splitList(services),
+		StartDateFrom: optionalString(from),
+		StartDateTo:   optionalString(to),
+	}, nil
+}
+
+func parseUUIDList(value string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, item := range splitList(value) {
+		id, err := uuid.Parse(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID %q", item)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseMembers parses a list of members given as ID or ID:SHARE
+func parseMembers(value string) ([]ports.MemberShare, error) {
+	var members []ports.MemberShare
+	for _, item := range splitList(value) {
+		member, err := parseMember(item)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// parseMember parses a member given as ID or ID:SHARE
+func parseMember(item string) (ports.MemberShare, error) {
+	id, share, hasShare := strings.Cut(item, ":")
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return ports.MemberShare{}, fmt.Errorf("invalid member user ID %q", id)
+	}
+	member := ports.MemberShare{UserID: userID}
+	if hasShare {
+		if member.Share, err = strconv.ParseInt(share, 10, 64); err != nil {
+			return ports.MemberShare{}, fmt.Errorf("invalid share %q of member %s", share, id)
+		}
+	}
+	return member, nil
+}
+
+// parseDiscounts parses a list of discounts given as KIND:VALUE:START or KIND:VALUE:START:END
+func parseDiscounts(value string) ([]ports.DiscountRule, error) {
+	var discounts []ports.DiscountRule
+	for _, item := range splitList(value) {
+		discount, err := parseDiscount(item)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+	return discounts, nil
+}
+
+// parseDiscount parses a discount given as KIND:VALUE:START or KIND:VALUE:START:END
+func parseDiscount(item string) (ports.DiscountRule, error) {
+	parts := strings.Split(item, ":")
+	if len(parts) < 3 || len(parts) > 4 {
+		return ports.DiscountRule{}, fmt.Errorf("invalid discount %q, expected KIND:VALUE:START[:END]", item)
+	}
+	amount, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return ports.DiscountRule{}, fmt.Errorf("invalid value %q of discount %q", parts[1], item)
+	}
+	discount := ports.DiscountRule{Kind: domain.DiscountKind(parts[0]), Value: amount, StartDate: parts[2]}
+	if len(parts) == 4 {
+		discount.EndDate = &parts[3]
+	}
+	return discount, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+)
+
+var csvHeader = []string{"id", "user_id", "service_name", "price", "currency", "tax_rate", "tax_inclusive", "start_date", "end_date", "trial_end_date", "pauses", "cancelled_at", "cancellation_reason", "category", "tags", "split", "members", "discounts", "created_at", "updated_at"}
+
+// csvListSeparator separates the items within the pauses, tags, members and discounts columns
+const csvListSeparator = ";"
+
+// requiredColumns must be present on import, the other columns of csvHeader are optional and unknown columns
+// are ignored. The price is a decimal amount in the currency, RUB when there is none, and includes the tax
+// unless tax_inclusive is false. Pauses are given as START or START:END months, cancelled_at in RFC 3339,
+// members as USER_ID or USER_ID:SHARE and discounts as KIND:VALUE:START or KIND:VALUE:START:END,
+// fixed shares and discounts in minor units.
+var requiredColumns = []string{"user_id", "service_name", "price", "start_date"}
+
+// csvRow is a parsed import row, err is set when the row is invalid
+type csvRow struct {
+	line    int
+	request *ports.CreateSubscriptionRequest
+	err     error
+}
+
+func writeCSV(w io.Writer, subscriptions []*domain.Subscription) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, s := range subscriptions {
+		endDate, trialEndDate := "", ""
+		if s.EndDate != nil {
+			endDate = *s.EndDate
+		}
+		if s.TrialEndDate != nil {
+			trialEndDate = *s.TrialEndDate
+		}
+		cancelledAt, reason := "", ""
+		if s.CancelledAt != nil {
+			cancelledAt = s.CancelledAt.Format(time.RFC3339)
+		}
+		if s.CancellationReason != nil {
+			reason = *s.CancellationReason
+		}
+
+		record := []string{
+			s.ID.String(),
+			s.UserID.String(),
+			s.ServiceName,
+			s.Price.Decimal(),
+			string(s.Price.Currency),
+			strconv.Itoa(s.Tax.Rate),
+			strconv.FormatBool(s.Tax.Inclusive),
+			s.StartDate,
+			endDate,
+			trialEndDate,
+			joinPauses(s.Pauses),
+			cancelledAt,
+			reason,
+			s.Category,
+			strings.Join(s.Tags, csvListSeparator),
+			string(s.Split),
+			joinMembers(s.Split, s.Members),
+			joinDiscounts(s.Discounts),
+			s.CreatedAt.Format(time.RFC3339),
+			s.UpdatedAt.Format(time.RFC3339),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// readCSV parses subscriptions to import. The header row selects the columns, so files
+// produced by export can be imported as is. Malformed rows are returned with their error.
+func readCSV(r io.Reader) ([]*csvRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("empty CSV file")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header is missing column %q", name)
+		}
+	}
+
+	var rows []*csvRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		request, err := parseRecord(record, columns)
+		rows = append(rows, &csvRow{line: line, request: request, err: err})
+	}
+}
+
+func parseRecord(record []string, columns map[string]int) (*ports.CreateSubscriptionRequest, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	userID, err := uuid.Parse(field("user_id"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id %q", field("user_id"))
+	}
+
+	currency := domain.Currency(field("currency"))
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+	price, err := domain.ParseMoney(field("price"), currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price %q", field("price"))
+	}
+
+	var taxRate int
+	if value := field("tax_rate"); value != "" {
+		if taxRate, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("invalid tax_rate %q", value)
+		}
+	}
+	var taxInclusive *bool
+	if value := field("tax_inclusive"); value != "" {
+		inclusive, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tax_inclusive %q", value)
+		}
+		taxInclusive = &inclusive
+	}
+
+	var members []ports.MemberShare
+	for _, item := range splitCSVList(field("members")) {
+		member, err := parseMember(item)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	var discounts []ports.DiscountRule
+	for _, item := range splitCSVList(field("discounts")) {
+		discount, err := parseDiscount(item)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+
+	var cancelledAt *time.Time
+	if value := field("cancelled_at"); value != "" {
+		at, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cancelled_at %q", value)
+		}
+		cancelledAt = &at
+	}
+
+	return &ports.CreateSubscriptionRequest{
+		UserID:             userID,
+		ServiceName:        field("service_name"),
+		Price:              price,
+		TaxRate:            taxRate,
+		TaxInclusive:       taxInclusive,
+		StartDate:          field("start_date"),
+		EndDate:            optionalString(field("end_date")),
+		TrialEndDate:       optionalString(field("trial_end_date")),
+		Pauses:             parsePauses(field("pauses")),
+		CancelledAt:        cancelledAt,
+		CancellationReason: optionalString(field("cancellation_reason")),
+		Category:           field("category"),
+		Tags:               splitCSVList(field("tags")),
+		Split:              domain.SplitRule(field("split")),
+		Members:            members,
+		Discounts:          discounts,
+	}, nil
+}
+
+// checkRequest validates an import row like CreateSubscription does, without storing it
+func checkRequest(request *ports.CreateSubscriptionRequest) error {
+	subscription, err := domain.NewSubscription(uuid.New(), request.ServiceName, request.Price,
+		request.UserID, request.StartDate, request.EndDate, request.TrialEndDate)
+	if err != nil {
+		return err
+	}
+
+	members := make([]domain.Member, len(request.Members))
+	for i, member := range request.Members {
+		members[i] = domain.Member{UserID: member.UserID, Share: member.Share}
+	}
+	if err = subscription.SplitBetween(request.Split, members); err != nil {
+		return err
+	}
+
+	discounts := make([]domain.Discount, len(request.Discounts))
+	for i, discount := range request.Discounts {
+		discounts[i] = domain.Discount{Kind: discount.Kind, Value: discount.Value, StartDate: discount.StartDate, EndDate: discount.EndDate}
+	}
+	if err = subscription.ApplyDiscounts(discounts); err != nil {
+		return err
+	}
+
+	pauses := make([]domain.Pause, len(request.Pauses))
+	for i, pause := range request.Pauses {
+		pauses[i] = domain.Pause{StartDate: pause.StartDate, EndDate: pause.EndDate}
+	}
+	if err = subscription.RestorePauses(pauses); err != nil {
+		return err
+	}
+	if request.CancelledAt != nil {
+		return subscription.RestoreCancellation(*request.CancelledAt, request.CancellationReason)
+	}
+	return nil
+}
+
+// joinPauses writes pauses as START or START:END months
+func joinPauses(pauses []domain.Pause) string {
+	items := make([]string, len(pauses))
+	for i, pause := range pauses {
+		items[i] = pause.StartDate
+		if pause.EndDate != nil {
+			items[i] += ":" + *pause.EndDate
+		}
+	}
+	return strings.Join(items, csvListSeparator)
+}
+
+// joinMembers writes members as USER_ID, or USER_ID:SHARE unless the price is split equally
+func joinMembers(split domain.SplitRule, members []domain.Member) string {
+	items := make([]string, len(members))
+	for i, member := range members {
+		items[i] = member.UserID.String()
+		if split != domain.SplitEqual {
+			items[i] += ":" + strconv.FormatInt(member.Share, 10)
+		}
+	}
+	return strings.Join(items, csvListSeparator)
+}
+
+// joinDiscounts writes discounts as KIND:VALUE:START or KIND:VALUE:START:END
+func joinDiscounts(discounts []domain.Discount) string {
+	items := make([]string, len(discounts))
+	for i, discount := range discounts {
+		items[i] = fmt.Sprintf("%s:%d:%s", discount.Kind, discount.Value, discount.StartDate)
+		if discount.EndDate != nil {
+			items[i] += ":" + *discount.EndDate
+		}
+	}
+	return strings.Join(items, csvListSeparator)
+}
+
+// parsePauses reads pauses written by joinPauses, their dates are validated with the subscription
+func parsePauses(value string) []ports.PausePeriod {
+	var pauses []ports.PausePeriod
+	for _, item := range splitCSVList(value) {
+		start, end, hasEnd := strings.Cut(item, ":")
+		pause := ports.PausePeriod{StartDate: start}
+		if hasEnd {
+			pause.EndDate = &end
+		}
+		pauses = append(pauses, pause)
+	}
+	return pauses
+}
+
+func splitCSVList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, csvListSeparator)
+}
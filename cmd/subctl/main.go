@@ -0,0 +1,188 @@
+// cmd/subctl/main.go
+//
+// subctl is an operator tool for subscriptions that works through the service layer
+// instead of raw SQL. Database settings are loaded like for the server (CONFIG_FILE, .env, env).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/core/usecase"
+	"subscription/internal/config"
+	"subscription/internal/logger"
+	"subscription/internal/repository/postgres"
+)
+
+const usage = `Usage: subctl [global flags] <command> [flags]
+
+Commands:
+  list         List subscriptions with optional filters
+  create       Create a subscription
+  trials       List subscriptions whose free trial ends soon
+  end          Set the end date of a subscription
+  pause        Pause a subscription
+  resume       Resume a paused subscription
+  cancel       Cancel a subscription with its last charged month
+  delete       Delete a subscription
+  total-cost   Calculate the total cost for a period
+  export       Export subscriptions to CSV
+  import       Import subscriptions from CSV
+  migrate      Run database migrations
+
+Global flags:
+`
+
+// errUsage signals invalid command line arguments, its details are already printed
+var errUsage = errors.New("invalid usage")
+
+// app holds the dependencies shared by commands
+type app struct {
+	service ports.SubscriptionService
+	client  *postgres.Client
+	out     io.Writer
+	output  string
+}
+
+type command func(ctx context.Context, a *app, args []string) error
+
+var commands = map[string]command{
+	"list":       runList,
+	"create":     runCreate,
+	"trials":     runTrials,
+	"end":        runEnd,
+	"pause":      runPause,
+	"resume":     runResume,
+	"cancel":     runCancel,
+	"delete":     runDelete,
+	"total-cost": runTotalCost,
+	"export":     runExport,
+	"import":     runImport,
+	"migrate":    runMigrate,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	global := flag.NewFlagSet("subctl", flag.ContinueOnError)
+	global.Usage = func() {
+		fmt.Fprint(global.Output(), usage)
+		global.PrintDefaults()
+	}
+
+	tenant := global.String("tenant", os.Getenv("SUBCTL_TENANT"), "tenant `UUID` to operate on (env SUBCTL_TENANT)")
+	output := global.String("o", outputTable, "output format: table or json")
+	logLevel := global.String("log-level", "warn", "log level")
+
+	if err := global.Parse(args); err != nil {
+		return 2
+	}
+
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(os.Stderr, "subctl: unknown output format %q\n", *output)
+		return 2
+	}
+
+	if global.NArg() == 0 {
+		global.Usage()
+		return 2
+	}
+
+	name := global.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "subctl: unknown command %q\n\n", name)
+		global.Usage()
+		return 2
+	}
+
+	if err := logger.Init(logger.Config{Level: *logLevel, Output: "console", TimeFormat: time.RFC3339}); err != nil {
+		fmt.Fprintf(os.Stderr, "subctl: %v\n", err)
+		return 2
+	}
+
+	// Every command except migrate works inside a tenant
+	var tenantID uuid.UUID
+	if name != "migrate" {
+		var err error
+		if tenantID, err = uuid.Parse(*tenant); err != nil || tenantID == uuid.Nil {
+			fmt.Fprintln(os.Stderr, "subctl: -tenant must be a non-nil UUID")
+			return 2
+		}
+	}
+
+	dbConfig, err := config.LoadDatabase()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "subctl: %v\n", err)
+		return 1
+	}
+	catalogConfig, err := config.LoadCatalog()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "subctl: %v\n", err)
+		return 1
+	}
+
+	client, err := postgres.NewClient(postgres.Params{
+		Host:            dbConfig.Host,
+		Port:            dbConfig.Port,
+		User:            dbConfig.User,
+		Password:        dbConfig.Password,
+		Name:            dbConfig.Name,
+		SSLMode:         dbConfig.SSLMode,
+		MaxOpenConns:    dbConfig.MaxOpenConns,
+		MaxIdleConns:    dbConfig.MaxIdleConns,
+		ConnMaxLifetime: dbConfig.ConnMaxLifetime,
+		ConnMaxIdleTime: dbConfig.ConnMaxIdleTime,
+		LegacyTenant:    dbConfig.LegacyTenantID(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "subctl: %v\n", err)
+		return 1
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// The operator acts as a tenant administrator
+	ctx = ports.WithPrincipal(ctx, &ports.Principal{
+		Kind:     ports.PrincipalSystem,
+		Subject:  "subctl",
+		Roles:    []string{ports.RoleAdmin},
+		TenantID: tenantID,
+	})
+
+	a := &app{
+		service: usecase.NewSubscriptionService(
+			postgres.NewSubscriptionRepository(client.DB),
+			postgres.NewCatalogRepository(client.DB),
+			domain.CatalogMode(catalogConfig.Mode),
+		),
+		client: client,
+		out:    os.Stdout,
+		output: *output,
+	}
+
+	if err = cmd(ctx, a, global.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(os.Stderr, "subctl %s: %v\n", name, err)
+		return 1
+	}
+
+	return 0
+}
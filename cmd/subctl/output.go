@@ -0,0 +1,275 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// subscriptionView is the printed representation of a subscription
+type subscriptionView struct {
+	ID          string         `json:"id"`
+	UserID      string         `json:"user_id"`
+	ServiceID   *uuid.UUID     `json:"service_id"`
+	ServiceName string         `json:"service_name"`
+	Price       moneyView      `json:"price"`
+	Tax         taxView        `json:"tax"`
+	StartDate   string         `json:"start_date"`
+	EndDate     *string        `json:"end_date"`
+	TrialEnd    *string        `json:"trial_end_date"`
+	Pauses      []pauseView    `json:"pauses"`
+	Status      string         `json:"status"`
+	CancelledAt *time.Time     `json:"cancelled_at"`
+	Reason      *string        `json:"cancellation_reason"`
+	Category    string         `json:"category"`
+	Tags        []string       `json:"tags"`
+	Split       string         `json:"split,omitempty"`
+	Members     []memberView   `json:"members"`
+	Discounts   []discountView `json:"discounts"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type pauseView struct {
+	StartDate string  `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+// moneyView is an amount in minor units of the currency
+type moneyView struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// String formats the amount in major units, e.g. "799.00 RUB"
+func (m moneyView) String() string {
+	return domain.NewMoney(m.Amount, domain.Currency(m.Currency)).String()
+}
+
+// taxView is the VAT of the price, the rate in basis points
+type taxView struct {
+	Rate      int  `json:"rate"`
+	Inclusive bool `json:"inclusive"`
+}
+
+type memberView struct {
+	UserID string `json:"user_id"`
+	Share  int64  `json:"share"`
+	Amount int64  `json:"amount"`
+}
+
+type discountView struct {
+	Kind      string  `json:"kind"`
+	Value     int64   `json:"value"`
+	StartDate string  `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+type importFailure struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+type importResult struct {
+	Imported int             `json:"imported"`
+	DryRun   bool            `json:"dry_run"`
+	Failed   []importFailure `json:"failed"`
+}
+
+func newSubscriptionView(s *domain.Subscription) subscriptionView {
+	view := subscriptionView{
+		ID:          s.ID.String(),
+		UserID:      s.UserID.String(),
+		ServiceID:   s.ServiceID,
+		ServiceName: s.ServiceName,
+		Price:       moneyView{Amount: s.Price.Amount, Currency: string(s.Price.Currency)},
+		Tax:         taxView{Rate: s.Tax.Rate, Inclusive: s.Tax.Inclusive},
+		StartDate:   s.StartDate,
+		EndDate:     s.EndDate,
+		TrialEnd:    s.TrialEndDate,
+		Pauses:      make([]pauseView, len(s.Pauses)),
+		Status:      string(s.Status()),
+		CancelledAt: s.CancelledAt,
+		Reason:      s.CancellationReason,
+		Category:    s.Category,
+		Tags:        s.Tags,
+		Split:       string(s.Split),
+		Members:     make([]memberView, len(s.Members)),
+		Discounts:   make([]discountView, len(s.Discounts)),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+	for i, pause := range s.Pauses {
+		view.Pauses[i] = pauseView{StartDate: pause.StartDate, EndDate: pause.EndDate}
+	}
+	for i, member := range s.Members {
+		view.Members[i] = memberView{UserID: member.UserID.String(), Share: member.Share, Amount: member.Amount}
+	}
+	for i, discount := range s.Discounts {
+		view.Discounts[i] = discountView{
+			Kind:      string(discount.Kind),
+			Value:     discount.Value,
+			StartDate: discount.StartDate,
+			EndDate:   discount.EndDate,
+		}
+	}
+	return view
+}
+
+func (a *app) printJSON(v interface{}) error {
+	encoder := json.NewEncoder(a.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (a *app) printSubscriptions(subscriptions []*domain.Subscription, meta *ports.PaginationMetadata) error {
+	views := make([]subscriptionView, len(subscriptions))
+	for i, s := range subscriptions {
+		views[i] = newSubscriptionView(s)
+	}
+
+	if a.output == outputJSON {
+		if meta == nil {
+			return a.printJSON(views)
+		}
+		return a.printJSON(struct {
+			Data       []subscriptionView        `json:"data"`
+			Pagination *ports.PaginationMetadata `json:"pagination"`
+		}{views, meta})
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tUSER ID\tSERVICE\tPRICE\tSTART\tEND\tTRIAL END\tPAUSES\tSTATUS")
+	for _, v := range views {
+		end, trialEnd, pauses := "-", "-", "-"
+		if v.EndDate != nil {
+			end = *v.EndDate
+		}
+		if v.TrialEnd != nil {
+			trialEnd = *v.TrialEnd
+		}
+		if len(v.Pauses) > 0 {
+			pauses = formatPauses(v.Pauses)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", v.ID, v.UserID, v.ServiceName, v.Price, v.StartDate, end, trialEnd, pauses, v.Status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if meta != nil {
+		_, err := fmt.Fprintf(a.out, "\npage %d of %d, %d subscriptions total\n", meta.Page, meta.TotalPages, meta.Total)
+		return err
+	}
+	return nil
+}
+
+// formatPauses lists pauses as "MM-YYYY–MM-YYYY", open pauses have no end
+func formatPauses(pauses []pauseView) string {
+	intervals := make([]string, len(pauses))
+	for i, pause := range pauses {
+		intervals[i] = pause.StartDate + "–"
+		if pause.EndDate != nil {
+			intervals[i] += *pause.EndDate
+		}
+	}
+	return strings.Join(intervals, ", ")
+}
+
+func (a *app) printSubscription(subscription *domain.Subscription) error {
+	if a.output == outputJSON {
+		return a.printJSON(newSubscriptionView(subscription))
+	}
+	return a.printSubscriptions([]*domain.Subscription{subscription}, nil)
+}
+
+func (a *app) printTotalCost(result *ports.TotalCostResponse) error {
+	if a.output == outputJSON {
+		return a.printJSON(result)
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "PERIOD\t%s – %s\n", result.Period.StartDate, result.Period.EndDate)
+	if len(result.FilterCriteria.UserIDs) > 0 {
+		users := make([]string, len(result.FilterCriteria.UserIDs))
+		for i, id := range result.FilterCriteria.UserIDs {
+			users[i] = id.String()
+		}
+		fmt.Fprintf(w, "USERS\t%s\n", strings.Join(users, ", "))
+		fmt.Fprintf(w, "ATTRIBUTION\t%s\n", result.FilterCriteria.Attribution)
+	}
+	if len(result.FilterCriteria.ServiceNames) > 0 {
+		fmt.Fprintf(w, "SERVICES\t%s\n", strings.Join(result.FilterCriteria.ServiceNames, ", "))
+	}
+	if len(result.FilterCriteria.Categories) > 0 {
+		fmt.Fprintf(w, "CATEGORIES\t%s\n", strings.Join(result.FilterCriteria.Categories, ", "))
+	}
+	if len(result.FilterCriteria.Tags) > 0 {
+		fmt.Fprintf(w, "TAGS\t%s\n", strings.Join(result.FilterCriteria.Tags, ", "))
+	}
+	fmt.Fprintf(w, "TOTAL COST\t%s\n", domain.NewMoney(result.TotalCost, result.Currency))
+	if result.Tax > 0 {
+		fmt.Fprintf(w, "NET COST\t%s\n", domain.NewMoney(result.NetCost, result.Currency))
+		fmt.Fprintf(w, "TAX\t%s\n", domain.NewMoney(result.Tax, result.Currency))
+	}
+	if result.Discount > 0 {
+		fmt.Fprintf(w, "DISCOUNT\t%s\n", domain.NewMoney(result.Discount, result.Currency))
+	}
+	for _, item := range result.Breakdown {
+		key := item.Key
+		if key == "" {
+			key = "(none)"
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", key, domain.NewMoney(item.TotalCost, result.Currency))
+	}
+	return w.Flush()
+}
+
+func (a *app) printImport(result importResult) error {
+	if a.output == outputJSON {
+		return a.printJSON(result)
+	}
+
+	verb := "imported"
+	if result.DryRun {
+		verb = "valid"
+	}
+	fmt.Fprintf(a.out, "%d rows %s, %d failed\n", result.Imported, verb, len(result.Failed))
+
+	if len(result.Failed) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tERROR")
+	for _, f := range result.Failed {
+		fmt.Fprintf(w, "%d\t%s\n", f.Line, f.Error)
+	}
+	return w.Flush()
+}
+
+// printResult prints a simple key/value result of a command
+func (a *app) printResult(result map[string]string) error {
+	if a.output == outputJSON {
+		return a.printJSON(result)
+	}
+
+	for key, value := range result {
+		if _, err := fmt.Fprintf(a.out, "%s: %s\n", key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// APIKeyScope limits the operations an API key may perform
+type APIKeyScope string
+
+const (
+	ScopeRead      APIKeyScope = "read"
+	ScopeWrite     APIKeyScope = "write"
+	ScopeAnalytics APIKeyScope = "analytics"
+	ScopeAdmin     APIKeyScope = "admin"
+)
+
+// AllAPIKeyScopes lists the supported API key scopes
+var AllAPIKeyScopes = []APIKeyScope{ScopeRead, ScopeWrite, ScopeAnalytics, ScopeAdmin}
+
+// APIKey represents a hashed credential for service-to-service access
+type APIKey struct {
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	Name       string
+	Prefix     string // Public part of the key used for lookup
+	Hash       string // SHA-256 of the full key, the key itself is never stored
+	Scopes     []APIKeyScope
+	ID         uuid.UUID
+	TenantID   uuid.UUID // Assigned from the creating principal by the repository
+}
+
+// NewAPIKey creates a new APIKey with validation
+func NewAPIKey(id uuid.UUID, name, prefix, hash string, scopes []APIKeyScope, expiresAt *time.Time) (*APIKey, error) {
+	key := &APIKey{
+		ID:        id,
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// Validate validates the API key business rules
+func (k *APIKey) Validate() error {
+	var errs ValidationErrors
+
+	if k.Name == "" {
+		errs.Add("name", "is required")
+	}
+
+	errs = append(errs, FieldErrors(ValidateAPIKeyScopes(k.Scopes))...)
+
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(k.CreatedAt) {
+		errs.Add("expires_at", "must be in the future")
+	}
+
+	return errs.Err()
+}
+
+// IsActive checks that the key is neither revoked nor expired at the given time
+func (k *APIKey) IsActive(at time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || at.Before(*k.ExpiresAt)
+}
+
+// HasScope checks whether the key grants the given scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// ValidateAPIKeyScopes checks that scopes are known and not empty
+func ValidateAPIKeyScopes(scopes []APIKeyScope) error {
+	if len(scopes) == 0 {
+		return NewValidationError("scopes", "must contain at least one scope")
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(AllAPIKeyScopes, scope) {
+			return NewValidationError("scopes", "contains unknown scope "+string(scope))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// BillingCycle is how often a service charges its subscribers.
+// Subscription prices are monthly whatever the cycle is.
+type BillingCycle string
+
+const (
+	BillingMonthly BillingCycle = "monthly"
+	BillingYearly  BillingCycle = "yearly"
+)
+
+// AllBillingCycles lists the supported billing cycles
+var AllBillingCycles = []BillingCycle{BillingMonthly, BillingYearly}
+
+// CatalogMode decides how service names of subscriptions are matched against the catalog
+type CatalogMode string
+
+const (
+	// CatalogOff keeps service names as free text
+	CatalogOff CatalogMode = "off"
+	// CatalogNormalize replaces known names and aliases with the canonical name, unknown names are kept
+	CatalogNormalize CatalogMode = "normalize"
+	// CatalogStrict works like CatalogNormalize but rejects unknown names
+	CatalogStrict CatalogMode = "strict"
+)
+
+// AllCatalogModes lists the supported catalog modes
+var AllCatalogModes = []CatalogMode{CatalogOff, CatalogNormalize, CatalogStrict}
+
+const (
+	maxServiceNameLength = 255
+	maxCategoryLength    = 100
+)
+
+// Service is a catalog entry of a service users subscribe to
+type Service struct {
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DefaultPrice *Money // Monthly price of subscriptions created without one
+	Name         string
+	Category     string
+	BillingCycle BillingCycle
+	Aliases      []string // Other spellings of the name, e.g. in other languages
+	ID           uuid.UUID
+	TenantID     uuid.UUID // Assigned from the creating principal by the repository
+}
+
+// NewService creates a new Service with validation, the billing cycle defaults to monthly
+func NewService(id uuid.UUID, name string, aliases []string, category string, defaultPrice *Money, billingCycle BillingCycle) (*Service, error) {
+	if billingCycle == "" {
+		billingCycle = BillingMonthly
+	}
+
+	service := &Service{
+		ID:           id,
+		Name:         strings.TrimSpace(name),
+		Aliases:      trimAll(aliases),
+		Category:     strings.TrimSpace(category),
+		DefaultPrice: defaultPrice,
+		BillingCycle: billingCycle,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := service.Validate(); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// Validate validates the catalog entry business rules
+func (s *Service) Validate() error {
+	var errs ValidationErrors
+
+	if s.Name == "" {
+		errs.Add("name", "must not be empty")
+	} else if utf8.RuneCountInString(s.Name) > maxServiceNameLength {
+		errs.Add("name", "must be at most 255 characters")
+	}
+
+	seen := []string{NormalizeServiceName(s.Name)}
+	for _, alias := range s.Aliases {
+		key := NormalizeServiceName(alias)
+		switch {
+		case key == "":
+			errs.Add("aliases", "must not contain empty names")
+		case utf8.RuneCountInString(alias) > maxServiceNameLength:
+			errs.Add("aliases", "must be at most 255 characters each")
+		case slices.Contains(seen, key):
+			errs.Add("aliases", "must differ from the name and each other: "+alias)
+		}
+		seen = append(seen, key)
+	}
+
+	if utf8.RuneCountInString(s.Category) > maxCategoryLength {
+		errs.Add("category", "must be at most 100 characters")
+	}
+	if s.DefaultPrice != nil {
+		errs.CheckPrice("default_price", *s.DefaultPrice)
+	}
+	if !slices.Contains(AllBillingCycles, s.BillingCycle) {
+		errs.Add("billing_cycle", "must be monthly or yearly")
+	}
+
+	return errs.Err()
+}
+
+// Keys returns the normalized name and aliases the entry is looked up by
+func (s *Service) Keys() []string {
+	keys := []string{NormalizeServiceName(s.Name)}
+	for _, alias := range s.Aliases {
+		if key := NormalizeServiceName(alias); !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// NormalizeServiceName folds case and whitespace, so that "Yandex  Plus" and "yandex plus" match
+func NormalizeServiceName(name string) string {
+	return foldName(name)
+}
+
+func foldName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// UseService links the subscription to a catalog entry and takes over its canonical name
+func (s *Subscription) UseService(service *Service) {
+	s.ServiceID = &service.ID
+	s.ServiceName = service.Name
+}
+
+func trimAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = strings.TrimSpace(value)
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxTags      = 20
+	maxTagLength = 50
+)
+
+// Classify sets the category and tags of the subscription, tags are normalized and deduplicated
+func (s *Subscription) Classify(category string, tags []string) error {
+	s.Category = strings.TrimSpace(category)
+	s.Tags = NormalizeTags(tags)
+
+	var errs ValidationErrors
+	errs.CheckClassification(s.Category, s.Tags)
+	return errs.Err()
+}
+
+// NormalizeTags folds case and whitespace of tags like service names and drops duplicates,
+// the result is never nil
+func NormalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = foldName(tag); !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// CheckClassification records the errors of a category and normalized tags
+func (v *ValidationErrors) CheckClassification(category string, tags []string) {
+	if utf8.RuneCountInString(category) > maxCategoryLength {
+		v.Add("category", fmt.Sprintf("must be at most %d characters", maxCategoryLength))
+	}
+
+	if len(tags) > maxTags {
+		v.Add("tags", fmt.Sprintf("must not contain more than %d tags", maxTags))
+	}
+	if slices.Contains(tags, "") {
+		v.Add("tags", "must not contain empty tags")
+	}
+	if slices.ContainsFunc(tags, func(tag string) bool { return utf8.RuneCountInString(tag) > maxTagLength }) {
+		v.Add("tags", fmt.Sprintf("must be at most %d characters each", maxTagLength))
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -78,3 +79,8 @@ func ParseDate(date string) (year, month int, err error) {
 
 	return year, month, nil
 }
+
+// FormatDate formats year and month as MM-YYYY
+func FormatDate(year, month int) string {
+	return fmt.Sprintf("%02d-%04d", month, year)
+}
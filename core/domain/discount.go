@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DiscountKind decides how a discount reduces the monthly price
+type DiscountKind string
+
+const (
+	// DiscountPercentage reduces the price by Value percent
+	DiscountPercentage DiscountKind = "percentage"
+	// DiscountFixed reduces the price by the amount Value, a larger amount makes the month free
+	DiscountFixed DiscountKind = "fixed"
+)
+
+// AllDiscountKinds lists the supported discount kinds
+var AllDiscountKinds = []DiscountKind{DiscountPercentage, DiscountFixed}
+
+const maxDiscounts = 20
+
+// Discount is a promotion reducing the price of a subscription in an interval of months,
+// e.g. the first 3 months at 50%. Discounted months in trial or paused are free anyway.
+type Discount struct {
+	EndDate   *string // Format: MM-YYYY, last discounted month, nil until the subscription ends
+	Kind      DiscountKind
+	StartDate string // Format: MM-YYYY, first discounted month
+	Value     int64  // Percent for DiscountPercentage, monthly amount in minor units for DiscountFixed
+}
+
+// covers checks if the discount includes the month given as year * 12 + month
+func (d Discount) covers(month int) bool {
+	return periodCovers(d.StartDate, d.EndDate, month)
+}
+
+// overlaps checks if both discounts include a common month
+func (d Discount) overlaps(other Discount) bool {
+	return d.covers(monthNumber(other.StartDate)) || other.covers(monthNumber(d.StartDate))
+}
+
+// ApplyDiscounts replaces the discounts of the subscription, they are ordered by start date
+func (s *Subscription) ApplyDiscounts(discounts []Discount) error {
+	discounts = OrderDiscounts(discounts)
+
+	var errs ValidationErrors
+	errs.CheckDiscounts(s.StartDate, s.EndDate, discounts)
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	s.Discounts = discounts
+	return nil
+}
+
+// OrderDiscounts returns a copy of discounts ordered by start date, the result is never nil
+func OrderDiscounts(discounts []Discount) []Discount {
+	ordered := append([]Discount{}, discounts...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return monthNumber(ordered[i].StartDate) < monthNumber(ordered[j].StartDate)
+	})
+	return ordered
+}
+
+// CheckDiscounts records the errors of discounts ordered by start date, every discount has to start
+// within the subscription period and discounts must not overlap. Discount periods are only checked
+// when the subscription dates are well-formed.
+func (v *ValidationErrors) CheckDiscounts(startDate string, endDate *string, discounts []Discount) {
+	if len(discounts) > maxDiscounts {
+		v.Add("discounts", fmt.Sprintf("must not contain more than %d discounts", maxDiscounts))
+	}
+
+	for i, discount := range discounts {
+		switch discount.Kind {
+		case DiscountPercentage:
+			if discount.Value <= 0 || discount.Value > 100 {
+				v.Add("discounts", "must have a value between 1 and 100 percent")
+			}
+		case DiscountFixed:
+			if discount.Value <= 0 || discount.Value > MaxAmount {
+				v.Add("discounts", fmt.Sprintf("must have a value between 1 and %d minor units", MaxAmount))
+			}
+		default:
+			v.Add("discounts", "kind must be one of percentage, fixed")
+		}
+
+		if !datePattern.MatchString(discount.StartDate) || (discount.EndDate != nil && !datePattern.MatchString(*discount.EndDate)) {
+			v.Add("discounts", dateFormatReason)
+			continue
+		}
+		if discount.EndDate != nil && monthNumber(*discount.EndDate) < monthNumber(discount.StartDate) {
+			v.Add("discounts", "discount starting "+discount.StartDate+" must not end before it starts")
+		}
+
+		if !datePattern.MatchString(startDate) || (endDate != nil && !datePattern.MatchString(*endDate)) {
+			continue
+		}
+		if !periodCovers(startDate, endDate, monthNumber(discount.StartDate)) {
+			v.Add("discounts", "discount starting "+discount.StartDate+" must start within the subscription period")
+		}
+		if i > 0 && discounts[i-1].overlaps(discount) {
+			v.Add("discounts", "discount starting "+discount.StartDate+" overlaps the previous discount")
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckDiscounts(t *testing.T) {
+	tests := []struct {
+		name        string
+		end         *string
+		discounts   []Discount
+		wantReasons []string
+	}{
+		{
+			name: "no discounts",
+		},
+		{
+			name: "adjacent discounts",
+			end:  datePtr("12-2025"),
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "01-2025", EndDate: datePtr("03-2025")},
+				{Kind: DiscountFixed, Value: 100, StartDate: "04-2025"},
+			},
+		},
+		{
+			name: "sharing a month",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "01-2025", EndDate: datePtr("03-2025")},
+				{Kind: DiscountPercentage, Value: 10, StartDate: "03-2025", EndDate: datePtr("05-2025")},
+			},
+			wantReasons: []string{"discount starting 03-2025 overlaps the previous discount"},
+		},
+		{
+			name: "after an open-ended discount",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "01-2025"},
+				{Kind: DiscountPercentage, Value: 10, StartDate: "01-2027"},
+			},
+			wantReasons: []string{"discount starting 01-2027 overlaps the previous discount"},
+		},
+		{
+			name: "starting with the subscription",
+			end:  datePtr("12-2025"),
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 100, StartDate: "01-2025", EndDate: datePtr("12-2025")},
+			},
+		},
+		{
+			name: "starting in the last month",
+			end:  datePtr("12-2025"),
+			discounts: []Discount{
+				{Kind: DiscountFixed, Value: MaxAmount, StartDate: "12-2025"},
+			},
+		},
+		{
+			name: "starting before the subscription",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "12-2024"},
+			},
+			wantReasons: []string{"discount starting 12-2024 must start within the subscription period"},
+		},
+		{
+			name: "starting after the end",
+			end:  datePtr("12-2025"),
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "01-2026"},
+			},
+			wantReasons: []string{"discount starting 01-2026 must start within the subscription period"},
+		},
+		{
+			name: "ending before it starts",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "03-2025", EndDate: datePtr("02-2025")},
+			},
+			wantReasons: []string{"discount starting 03-2025 must not end before it starts"},
+		},
+		{
+			name: "malformed date",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 50, StartDate: "2025-03"},
+			},
+			wantReasons: []string{dateFormatReason},
+		},
+		{
+			name: "percentage out of range",
+			discounts: []Discount{
+				{Kind: DiscountPercentage, Value: 101, StartDate: "01-2025", EndDate: datePtr("01-2025")},
+				{Kind: DiscountPercentage, Value: 0, StartDate: "02-2025"},
+			},
+			wantReasons: []string{
+				"must have a value between 1 and 100 percent",
+				"must have a value between 1 and 100 percent",
+			},
+		},
+		{
+			name: "fixed amount out of range",
+			discounts: []Discount{
+				{Kind: DiscountFixed, Value: MaxAmount + 1, StartDate: "01-2025"},
+			},
+			wantReasons: []string{"must have a value between 1 and 9007199254740991 minor units"},
+		},
+		{
+			name: "unknown kind",
+			discounts: []Discount{
+				{Kind: "coupon", Value: 10, StartDate: "01-2025"},
+			},
+			wantReasons: []string{"kind must be one of percentage, fixed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+
+			errs.CheckDiscounts("01-2025", tt.end, tt.discounts)
+
+			if got := reasons(errs); !reflect.DeepEqual(got, tt.wantReasons) {
+				t.Errorf("reasons = %q, want %q", got, tt.wantReasons)
+			}
+		})
+	}
+}
+
+func TestCheckDiscountsLimit(t *testing.T) {
+	discounts := make([]Discount, maxDiscounts+1)
+	for i := range discounts {
+		start := formatMonthNumber(monthNumber("01-2025") + i)
+		discounts[i] = Discount{Kind: DiscountPercentage, Value: 10, StartDate: start, EndDate: datePtr(start)}
+	}
+
+	var errs ValidationErrors
+	errs.CheckDiscounts("01-2025", nil, discounts[:maxDiscounts])
+	if len(errs) != 0 {
+		t.Fatalf("reasons = %q for %d discounts, want none", reasons(errs), maxDiscounts)
+	}
+
+	errs.CheckDiscounts("01-2025", nil, discounts)
+	if want := []string{"must not contain more than 20 discounts"}; !reflect.DeepEqual(reasons(errs), want) {
+		t.Errorf("reasons = %q, want %q", reasons(errs), want)
+	}
+}
+
+func TestOrderDiscounts(t *testing.T) {
+	discounts := []Discount{
+		{Kind: DiscountFixed, Value: 100, StartDate: "01-2026"},
+		{Kind: DiscountPercentage, Value: 50, StartDate: "12-2025"},
+		{Kind: DiscountPercentage, Value: 10, StartDate: "02-2025"},
+	}
+
+	got := OrderDiscounts(discounts)
+
+	want := []Discount{discounts[2], discounts[1], discounts[0]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderDiscounts() = %v, want %v", got, want)
+	}
+	if discounts[0].StartDate != "01-2026" {
+		t.Error("OrderDiscounts() reordered its argument")
+	}
+	if got := OrderDiscounts(nil); got == nil || len(got) != 0 {
+		t.Errorf("OrderDiscounts(nil) = %#v, want an empty slice", got)
+	}
+}
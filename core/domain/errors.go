@@ -1,26 +1,49 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type domainErrorCodes int
 
 const (
-	_                   domainErrorCodes = iota
-	ValidationError                      = 400
-	NotFoundError                        = 404
-	DuplicateError                       = 422
-	InternalServerError                  = 500
+	_                        domainErrorCodes = iota
+	ValidationError                           = 400
+	UnauthorizedError                         = 401
+	ForbiddenError                            = 403
+	NotFoundError                             = 404
+	ConflictError                             = 409
+	DuplicateError                            = 422
+	UnprocessableEntityError                  = 422
+	InternalServerError                       = 500
 )
 
 // Error definitions for the core domain
 var (
 	ErrSubscriptionNotFound  = NewDomainError(NotFoundError, "subscription not found")
-	ErrDuplicateSubscription = NewDomainError(DuplicateError, "DuplicateError subscription")
 	ErrInvalidDateformat     = NewDomainError(ValidationError, "invalid date format, expected MM-YYYY")
 	ErrInvalidUUID           = NewDomainError(ValidationError, "invalid UUID format")
 	ErrInvalidPrice          = NewDomainError(ValidationError, "price must be positive integer")
+	ErrCurrencyMismatch      = NewDomainError(ValidationError, "amounts in different currencies cannot be combined")
+	ErrAmountOverflow        = NewDomainError(ValidationError, "amount is out of range")
 	ErrStartDateAfterEndDate = NewDomainError(ValidationError, "start date cannot be after end date")
 	ErrInvalidDateRange      = NewDomainError(ValidationError, "invalid date range")
 	ErrValidationFailed      = NewDomainError(ValidationError, "validation failed")
 	ErrInternal              = NewDomainError(InternalServerError, "internal server error")
+	ErrUnauthorized          = NewDomainError(UnauthorizedError, "authentication required")
+	ErrForbidden             = NewDomainError(ForbiddenError, "access to the resource is forbidden")
+	ErrAPIKeyNotFound        = NewDomainError(NotFoundError, "API key not found")
+	ErrTenantRequired        = NewDomainError(ForbiddenError, "tenant is required")
+	ErrPauseOverlap          = NewDomainError(ConflictError, "pause overlaps an existing pause of the subscription")
+	ErrSubscriptionNotPaused = NewDomainError(ConflictError, "subscription is not paused in the given month")
+	ErrServiceNotFound       = NewDomainError(NotFoundError, "service not found")
+	ErrServiceNameTaken      = NewDomainError(ConflictError, "name or alias is already used by another service")
+	ErrServiceInUse          = NewDomainError(ConflictError, "service is referenced by subscriptions")
+
+	ErrIdempotencyKeyInProgress = NewDomainError(ConflictError, "request with this idempotency key is still in progress")
+	ErrIdempotencyKeyMismatch   = NewDomainError(UnprocessableEntityError, "idempotency key was already used with a different payload")
 )
 
 // DomainError represents a domain-specific error
@@ -54,3 +77,98 @@ func NewValidationError(field, reason string) *DomainError {
 		},
 	}
 }
+
+// overlapErrorType marks the details of overlap errors
+const overlapErrorType = "overlap"
+
+// NewOverlapError reports that a subscription overlaps existing, another subscription of the user to the same service
+func NewOverlapError(existing *Subscription) *DomainError {
+	until := "open-ended"
+	if existing.EndDate != nil {
+		until = "until " + *existing.EndDate
+	}
+
+	return &DomainError{
+		Code: ConflictError,
+		Message: fmt.Sprintf("subscription overlaps the %s subscription %s of the user starting %s (%s)",
+			existing.ServiceName, existing.ID, existing.StartDate, until),
+		Details: map[string]interface{}{
+			"conflicting_subscription_id": existing.ID.String(),
+			"type":                        overlapErrorType,
+		},
+	}
+}
+
+// NewTransitionError reports that a subscription cannot move from its status to another one
+func NewTransitionError(from, to Status) *DomainError {
+	return &DomainError{
+		Code:    ConflictError,
+		Message: fmt.Sprintf("subscription cannot become %s while it is %s", to, from),
+		Details: map[string]interface{}{
+			"status": string(from),
+			"type":   "transition",
+		},
+	}
+}
+
+// IsOverlapError checks if err reports overlapping subscriptions
+func IsOverlapError(err error) bool {
+	var domainErr *DomainError
+	return errors.As(err, &domainErr) && domainErr.Details["type"] == overlapErrorType
+}
+
+// FieldError describes why the value of a single field is invalid
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ValidationErrors collects field errors, so that every invalid field is reported at once
+type ValidationErrors []FieldError
+
+// Add records that field is invalid for reason
+func (v *ValidationErrors) Add(field, reason string) {
+	*v = append(*v, FieldError{Field: field, Reason: reason})
+}
+
+// Err returns the collected field errors as a validation error, or nil when there are none
+func (v ValidationErrors) Err() error {
+	switch len(v) {
+	case 0:
+		return nil
+	case 1:
+		return NewValidationError(v[0].Field, v[0].Reason)
+	}
+
+	fields := make([]string, len(v))
+	for i, fieldErr := range v {
+		fields[i] = fieldErr.Field
+	}
+
+	return &DomainError{
+		Code:    ValidationError,
+		Message: "Validation failed for fields: " + strings.Join(fields, ", "),
+		Details: map[string]interface{}{
+			"errors": []FieldError(v),
+			"type":   "validation",
+		},
+	}
+}
+
+// FieldErrors returns the field errors carried by err, it is empty for errors not tied to fields
+func FieldErrors(err error) []FieldError {
+	var domainErr *DomainError
+	if !errors.As(err, &domainErr) {
+		return nil
+	}
+
+	if fieldErrs, ok := domainErr.Details["errors"].([]FieldError); ok {
+		return fieldErrs
+	}
+	if field, ok := domainErr.Details["field"].(string); ok {
+		reason, _ := domainErr.Details["reason"].(string)
+		return []FieldError{{Field: field, Reason: reason}}
+	}
+
+	return nil
+}
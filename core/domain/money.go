@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code, e.g. "RUB" or "EUR"
+type Currency string
+
+// DefaultCurrency is the currency of prices given without one
+const DefaultCurrency Currency = "RUB"
+
+// MaxAmount bounds amounts in minor units, they stay exact as JSON numbers in any client
+const MaxAmount int64 = 1<<53 - 1
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// minorUnitDigits lists the currencies not divided into cents, all others have two decimal places
+var minorUnitDigits = map[Currency]int{
+	"BHD": 3, "CLP": 0, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// Valid checks if the currency is a three-letter upper-case code
+func (c Currency) Valid() bool {
+	return currencyPattern.MatchString(string(c))
+}
+
+// Digits returns the number of decimal places of the currency, e.g. 2 for kopecks of RUB
+func (c Currency) Digits() int {
+	if digits, ok := minorUnitDigits[c]; ok {
+		return digits
+	}
+	return 2
+}
+
+// Money is an amount in minor units of a currency, 79900 RUB is 799.00 rubles.
+// Arithmetic is done on integers and fails instead of rounding or overflowing.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney returns amount minor units of currency
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney parses a decimal amount in major units like "799" or "7.99", it must not have
+// more decimal places than the currency
+func ParseMoney(amount string, currency Currency) (Money, error) {
+	units, fraction, _ := strings.Cut(strings.TrimSpace(amount), ".")
+	digits := currency.Digits()
+	if units == "" || len(fraction) > digits || strings.HasPrefix(units, "+") {
+		return Money{}, NewValidationError("amount", fmt.Sprintf("must be a decimal with at most %d decimal places", digits))
+	}
+
+	minor, err := strconv.ParseInt(units+fraction+strings.Repeat("0", digits-len(fraction)), 10, 64)
+	if err != nil || minor > MaxAmount || minor < -MaxAmount {
+		return Money{}, NewValidationError("amount", fmt.Sprintf("must be a decimal with at most %d decimal places", digits))
+	}
+	return NewMoney(minor, currency), nil
+}
+
+// IsPositive checks if the amount is above zero
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// Add returns the sum of both amounts, they must be in the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, ErrAmountOverflow
+	}
+	return NewMoney(m.Amount+other.Amount, m.Currency), nil
+}
+
+// Sub returns the difference of both amounts, they must be in the same currency
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, ErrAmountOverflow
+	}
+	return m.Add(NewMoney(-other.Amount, other.Currency))
+}
+
+// Mul returns the amount multiplied by factor, e.g. the price of several months
+func (m Money) Mul(factor int64) (Money, error) {
+	if factor != 0 && (m.Amount*factor/factor != m.Amount || (m.Amount == math.MinInt64 && factor == -1)) {
+		return Money{}, ErrAmountOverflow
+	}
+	return NewMoney(m.Amount*factor, m.Currency), nil
+}
+
+// Decimal formats the amount in major units with the decimal places of the currency, e.g. "799.00"
+func (m Money) Decimal() string {
+	digits := m.Currency.Digits()
+	sign, amount := "", strconv.FormatUint(absAmount(m.Amount), 10)
+	if m.Amount < 0 {
+		sign = "-"
+	}
+	if digits == 0 {
+		return sign + amount
+	}
+	if len(amount) <= digits {
+		amount = strings.Repeat("0", digits-len(amount)+1) + amount
+	}
+	return sign + amount[:len(amount)-digits] + "." + amount[len(amount)-digits:]
+}
+
+// String formats the money as decimal amount and currency, e.g. "799.00 RUB"
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// absAmount returns the absolute value of amount, also of the smallest int64
+func absAmount(amount int64) uint64 {
+	if amount < 0 {
+		return uint64(-(amount + 1)) + 1
+	}
+	return uint64(amount)
+}
+
+// CheckPrice records the errors of a price, it has to be positive, at most MaxAmount and in a valid currency
+func (v *ValidationErrors) CheckPrice(field string, price Money) {
+	switch {
+	case price.Amount <= 0:
+		v.Add(field, "must be a positive amount")
+	case price.Amount > MaxAmount:
+		v.Add(field, fmt.Sprintf("must not exceed %d minor units", MaxAmount))
+	}
+	if !price.Currency.Valid() {
+		v.Add(field, "currency must be a three-letter ISO 4217 code")
+	}
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency Currency
+		want     int64
+		wantCode int
+	}{
+		{name: "whole units", amount: "799", currency: "RUB", want: 79900},
+		{name: "cents", amount: "7.99", currency: "EUR", want: 799},
+		{name: "one decimal place", amount: "7.5", currency: "EUR", want: 750},
+		{name: "trailing dot", amount: "7.", currency: "EUR", want: 700},
+		{name: "surrounding spaces", amount: " 12.30 ", currency: "USD", want: 1230},
+		{name: "negative", amount: "-1.50", currency: "EUR", want: -150},
+		{name: "zero decimal currency", amount: "1500", currency: "JPY", want: 1500},
+		{name: "three decimal currency", amount: "1.234", currency: "KWD", want: 1234},
+		{name: "largest amount", amount: "90071992547409.91", currency: "EUR", want: MaxAmount},
+		{name: "smallest amount", amount: "-90071992547409.91", currency: "EUR", want: -MaxAmount},
+		{name: "above the largest amount", amount: "90071992547409.92", currency: "EUR", wantCode: ValidationError},
+		{name: "beyond int64", amount: "99999999999999999999", currency: "EUR", wantCode: ValidationError},
+		{name: "too many decimal places", amount: "7.999", currency: "EUR", wantCode: ValidationError},
+		{name: "decimals of a zero decimal currency", amount: "1500.0", currency: "JPY", wantCode: ValidationError},
+		{name: "missing units", amount: ".99", currency: "EUR", wantCode: ValidationError},
+		{name: "explicit plus", amount: "+7", currency: "EUR", wantCode: ValidationError},
+		{name: "not a number", amount: "7,99", currency: "EUR", wantCode: ValidationError},
+		{name: "empty", amount: "", currency: "EUR", wantCode: ValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, tt.currency)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("ParseMoney() error = %v, want code %d", err, tt.wantCode)
+			}
+			if tt.wantCode == 0 && got != NewMoney(tt.want, tt.currency) {
+				t.Errorf("ParseMoney() = %v, want %d %s", got, tt.want, tt.currency)
+			}
+		})
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  string
+	}{
+		{name: "cents", money: NewMoney(79900, "RUB"), want: "799.00"},
+		{name: "below one unit", money: NewMoney(5, "EUR"), want: "0.05"},
+		{name: "zero", money: NewMoney(0, "EUR"), want: "0.00"},
+		{name: "negative below one unit", money: NewMoney(-5, "EUR"), want: "-0.05"},
+		{name: "zero decimal currency", money: NewMoney(1500, "JPY"), want: "1500"},
+		{name: "three decimal currency", money: NewMoney(1234, "KWD"), want: "1.234"},
+		{name: "largest int64", money: NewMoney(math.MaxInt64, "EUR"), want: "92233720368547758.07"},
+		{name: "smallest int64", money: NewMoney(math.MinInt64, "EUR"), want: "-92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Decimal(); got != tt.want {
+				t.Errorf("Decimal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func() (Money, error)
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "add",
+			op:   func() (Money, error) { return NewMoney(150, "EUR").Add(NewMoney(250, "EUR")) },
+			want: 400,
+		},
+		{
+			name:    "add across currencies",
+			op:      func() (Money, error) { return NewMoney(150, "EUR").Add(NewMoney(250, "USD")) },
+			wantErr: ErrCurrencyMismatch,
+		},
+		{
+			name: "add up to the largest int64",
+			op:   func() (Money, error) { return NewMoney(math.MaxInt64-1, "EUR").Add(NewMoney(1, "EUR")) },
+			want: math.MaxInt64,
+		},
+		{
+			name:    "add beyond int64",
+			op:      func() (Money, error) { return NewMoney(math.MaxInt64, "EUR").Add(NewMoney(1, "EUR")) },
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name:    "subtract beyond int64",
+			op:      func() (Money, error) { return NewMoney(math.MinInt64, "EUR").Sub(NewMoney(1, "EUR")) },
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name:    "subtract the smallest int64",
+			op:      func() (Money, error) { return NewMoney(0, "EUR").Sub(NewMoney(math.MinInt64, "EUR")) },
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name: "multiply",
+			op:   func() (Money, error) { return NewMoney(79900, "RUB").Mul(12) },
+			want: 958800,
+		},
+		{
+			name: "multiply by zero",
+			op:   func() (Money, error) { return NewMoney(math.MaxInt64, "EUR").Mul(0) },
+			want: 0,
+		},
+		{
+			name: "multiply up to the largest int64",
+			op:   func() (Money, error) { return NewMoney(math.MaxInt64/7, "EUR").Mul(7) },
+			want: math.MaxInt64 / 7 * 7,
+		},
+		{
+			name:    "multiply beyond int64",
+			op:      func() (Money, error) { return NewMoney(MaxAmount, "EUR").Mul(1025) },
+			wantErr: ErrAmountOverflow,
+		},
+		{
+			name:    "negate the smallest int64",
+			op:      func() (Money, error) { return NewMoney(math.MinInt64, "EUR").Mul(-1) },
+			wantErr: ErrAmountOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.Amount != tt.want {
+				t.Errorf("amount = %d, want %d", got.Amount, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPrice(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       Money
+		wantReasons []string
+	}{
+		{name: "positive", price: NewMoney(1, "RUB")},
+		{name: "largest amount", price: NewMoney(MaxAmount, "EUR")},
+		{name: "zero", price: NewMoney(0, "RUB"), wantReasons: []string{"must be a positive amount"}},
+		{name: "above the largest amount", price: NewMoney(MaxAmount+1, "EUR"), wantReasons: []string{"must not exceed 9007199254740991 minor units"}},
+		{name: "lower-case currency", price: NewMoney(100, "eur"), wantReasons: []string{"currency must be a three-letter ISO 4217 code"}},
+		{
+			name:  "negative without currency",
+			price: NewMoney(-1, ""),
+			wantReasons: []string{
+				"must be a positive amount",
+				"currency must be a three-letter ISO 4217 code",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+
+			errs.CheckPrice("price", tt.price)
+
+			if got := reasons(errs); !reflect.DeepEqual(got, tt.wantReasons) {
+				t.Errorf("reasons = %q, want %q", got, tt.wantReasons)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Pause is an interval of months in which a subscription is suspended and not charged
+type Pause struct {
+	EndDate   *string // Format: MM-YYYY, last paused month, nil until the subscription is resumed
+	StartDate string  // Format: MM-YYYY, first paused month
+}
+
+// covers checks if the pause includes the month given as year * 12 + month
+func (p Pause) covers(month int) bool {
+	return periodCovers(p.StartDate, p.EndDate, month)
+}
+
+// overlaps checks if both pauses include a common month
+func (p Pause) overlaps(other Pause) bool {
+	return p.covers(monthNumber(other.StartDate)) || other.covers(monthNumber(p.StartDate))
+}
+
+// Pause suspends the subscription from startDate until endDate, or until it is resumed when endDate is nil.
+// The pause must not start in the past, has to start within the subscription period and must not overlap
+// other pauses. The subscription has to be in trial or active in its first month, so a pause can be
+// scheduled while an earlier pause is running.
+func (s *Subscription) Pause(startDate string, endDate *string) error {
+	var errs ValidationErrors
+	errs.CheckPeriod("start_date", startDate, "end_date", endDate)
+	if datePattern.MatchString(startDate) && monthNumber(startDate) < monthNumber(CurrentDate()) {
+		errs.Add("start_date", "must not be in the past")
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	pause := Pause{StartDate: startDate, EndDate: endDate}
+	if !periodCovers(s.StartDate, s.EndDate, monthNumber(startDate)) {
+		return NewValidationError("start_date", "must lie within the subscription period")
+	}
+	for _, existing := range s.Pauses {
+		if existing.overlaps(pause) {
+			return ErrPauseOverlap
+		}
+	}
+
+	if err := s.transitionAt(startDate, StatusPaused); err != nil {
+		return err
+	}
+
+	s.Pauses = append(s.Pauses, pause)
+	sort.Slice(s.Pauses, func(i, j int) bool {
+		return monthNumber(s.Pauses[i].StartDate) < monthNumber(s.Pauses[j].StartDate)
+	})
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// RestorePauses sets the pauses of a subscription restored from an export. Unlike Pause it accepts
+// pauses in the past, they have to start within the subscription period and must not overlap.
+func (s *Subscription) RestorePauses(pauses []Pause) error {
+	sort.Slice(pauses, func(i, j int) bool {
+		return monthNumber(pauses[i].StartDate) < monthNumber(pauses[j].StartDate)
+	})
+
+	var errs ValidationErrors
+	errs.CheckPauses(s.StartDate, s.EndDate, pauses)
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	s.Pauses = pauses
+	return nil
+}
+
+// Resume ends the pause that covers the month of date, the subscription is charged again from that month.
+// A pause starting in that month is removed, which also drops a scheduled pause. The date must not be
+// in the past and the subscription has to be paused in its month.
+func (s *Subscription) Resume(date string) error {
+	if !datePattern.MatchString(date) {
+		return NewValidationError("date", dateFormatReason)
+	}
+
+	month := monthNumber(date)
+	if month < monthNumber(CurrentDate()) {
+		return NewValidationError("date", "must not be in the past")
+	}
+	for i, pause := range s.Pauses {
+		if !pause.covers(month) {
+			continue
+		}
+		if err := s.transitionAt(date, StatusActive); err != nil {
+			return err
+		}
+
+		if monthNumber(pause.StartDate) == month {
+			s.Pauses = append(s.Pauses[:i], s.Pauses[i+1:]...)
+		} else {
+			lastPaused := formatMonthNumber(month - 1)
+			s.Pauses[i].EndDate = &lastPaused
+		}
+		s.UpdatedAt = time.Now()
+
+		return nil
+	}
+
+	return ErrSubscriptionNotPaused
+}
+
+// PausedIn checks if the subscription is paused in the month of the provided date
+func (s *Subscription) PausedIn(referenceDate string) (bool, error) {
+	if err := ValidateDateFormat(referenceDate); err != nil {
+		return false, err
+	}
+
+	month := monthNumber(referenceDate)
+	for _, pause := range s.Pauses {
+		if pause.covers(month) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// periodCovers checks if the month given as year * 12 + month lies within the period, endDate is optional
+func periodCovers(startDate string, endDate *string, month int) bool {
+	if month < monthNumber(startDate) {
+		return false
+	}
+	return endDate == nil || month <= monthNumber(*endDate)
+}
+
+// monthNumber converts a MM-YYYY date to year * 12 + month, malformed dates give 0
+func monthNumber(date string) int {
+	year, month, err := ParseDate(date)
+	if err != nil {
+		return 0
+	}
+	return year*12 + month
+}
+
+// formatMonthNumber converts year * 12 + month back to a MM-YYYY date
+func formatMonthNumber(number int) string {
+	return FormatDate((number-1)/12, (number-1)%12+1)
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// month returns the MM-YYYY date offset months from the current month
+func month(offset int) string {
+	return formatMonthNumber(monthNumber(CurrentDate()) + offset)
+}
+
+func datePtr(date string) *string {
+	return &date
+}
+
+func errorCode(err error) int {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return 0
+}
+
+func TestSubscriptionPause(t *testing.T) {
+	cancelledAt := time.Now()
+
+	tests := []struct {
+		name       string
+		pauses     []Pause
+		cancelled  bool
+		start      string
+		end        *string
+		wantCode   int
+		wantPauses []Pause
+	}{
+		{
+			name:     "active subscription",
+			start:    month(1),
+			end:      datePtr(month(2)),
+			wantCode: 0,
+			wantPauses: []Pause{
+				{StartDate: month(1), EndDate: datePtr(month(2))},
+			},
+		},
+		{
+			name:     "future pause while paused now",
+			pauses:   []Pause{{StartDate: month(-1), EndDate: datePtr(month(1))}},
+			start:    month(3),
+			end:      datePtr(month(4)),
+			wantCode: 0,
+			wantPauses: []Pause{
+				{StartDate: month(-1), EndDate: datePtr(month(1))},
+				{StartDate: month(3), EndDate: datePtr(month(4))},
+			},
+		},
+		{
+			name:     "pause before a scheduled pause",
+			pauses:   []Pause{{StartDate: month(4), EndDate: datePtr(month(5))}},
+			start:    month(0),
+			end:      datePtr(month(1)),
+			wantCode: 0,
+			wantPauses: []Pause{
+				{StartDate: month(0), EndDate: datePtr(month(1))},
+				{StartDate: month(4), EndDate: datePtr(month(5))},
+			},
+		},
+		{
+			name:     "overlapping the running pause",
+			pauses:   []Pause{{StartDate: month(-1), EndDate: datePtr(month(1))}},
+			start:    month(1),
+			end:      datePtr(month(2)),
+			wantCode: ConflictError,
+		},
+		{
+			name:     "overlapping an open-ended pause",
+			pauses:   []Pause{{StartDate: month(2)}},
+			start:    month(5),
+			wantCode: ConflictError,
+		},
+		{
+			name:     "starting in the past",
+			start:    month(-1),
+			end:      datePtr(month(1)),
+			wantCode: ValidationError,
+		},
+		{
+			name:      "cancelled subscription",
+			cancelled: true,
+			start:     month(1),
+			end:       datePtr(month(2)),
+			wantCode:  ConflictError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{StartDate: month(-12), Pauses: tt.pauses}
+			if tt.cancelled {
+				s.EndDate = datePtr(month(6))
+				s.CancelledAt = &cancelledAt
+			}
+
+			err := s.Pause(tt.start, tt.end)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("Pause() error = %v, want code %d", err, tt.wantCode)
+			}
+			if tt.wantCode == 0 && !reflect.DeepEqual(s.Pauses, tt.wantPauses) {
+				t.Errorf("Pauses = %v, want %v", s.Pauses, tt.wantPauses)
+			}
+		})
+	}
+}
+
+func TestSubscriptionResume(t *testing.T) {
+	tests := []struct {
+		name       string
+		pauses     []Pause
+		date       string
+		wantErr    error
+		wantCode   int
+		wantPauses []Pause
+	}{
+		{
+			name:       "running pause",
+			pauses:     []Pause{{StartDate: month(-2), EndDate: datePtr(month(2))}},
+			date:       month(0),
+			wantPauses: []Pause{{StartDate: month(-2), EndDate: datePtr(month(-1))}},
+		},
+		{
+			name:       "shorten a scheduled pause",
+			pauses:     []Pause{{StartDate: month(2), EndDate: datePtr(month(5))}},
+			date:       month(4),
+			wantPauses: []Pause{{StartDate: month(2), EndDate: datePtr(month(3))}},
+		},
+		{
+			name:       "drop a scheduled pause",
+			pauses:     []Pause{{StartDate: month(2), EndDate: datePtr(month(5))}},
+			date:       month(2),
+			wantPauses: []Pause{},
+		},
+		{
+			name: "scheduled pause while paused now",
+			pauses: []Pause{
+				{StartDate: month(-1), EndDate: datePtr(month(1))},
+				{StartDate: month(3)},
+			},
+			date: month(6),
+			wantPauses: []Pause{
+				{StartDate: month(-1), EndDate: datePtr(month(1))},
+				{StartDate: month(3), EndDate: datePtr(month(5))},
+			},
+		},
+		{
+			name:     "month without pause",
+			pauses:   []Pause{{StartDate: month(2), EndDate: datePtr(month(5))}},
+			date:     month(1),
+			wantErr:  ErrSubscriptionNotPaused,
+			wantCode: ConflictError,
+		},
+		{
+			name:     "month in the past",
+			pauses:   []Pause{{StartDate: month(-3), EndDate: datePtr(month(2))}},
+			date:     month(-1),
+			wantCode: ValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{StartDate: month(-12), Pauses: tt.pauses}
+
+			err := s.Resume(tt.date)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("Resume() error = %v, want code %d", err, tt.wantCode)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resume() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantCode == 0 && !reflect.DeepEqual(s.Pauses, tt.wantPauses) {
+				t.Errorf("Pauses = %v, want %v", s.Pauses, tt.wantPauses)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// SplitRule decides how the price of a shared subscription is divided between its members
+type SplitRule string
+
+const (
+	// SplitEqual divides the price into equal parts
+	SplitEqual SplitRule = "equal"
+	// SplitPercentage gives every member its Share in percent of the price
+	SplitPercentage SplitRule = "percentage"
+	// SplitFixed gives every member its Share as monthly amount, the shares add up to the price
+	SplitFixed SplitRule = "fixed"
+)
+
+// AllSplitRules lists the supported split rules
+var AllSplitRules = []SplitRule{SplitEqual, SplitPercentage, SplitFixed}
+
+const maxMembers = 20
+
+// Member is a user sharing the cost of a subscription
+type Member struct {
+	UserID uuid.UUID
+	Share  int64 // Percent for SplitPercentage, monthly amount in minor units for SplitFixed, unused for SplitEqual
+	Amount int64 // Monthly part of the price in minor units the member bears, derived from the price, split rule and share
+}
+
+// IsShared checks if the price of the subscription is divided between members
+func (s *Subscription) IsShared() bool {
+	return len(s.Members) > 0
+}
+
+// SplitBetween shares the subscription between members by rule, the split rule defaults to equal.
+// The paying user only bears a part when listed as member. Without members the subscription
+// is not shared and its payer bears the full price.
+func (s *Subscription) SplitBetween(rule SplitRule, members []Member) error {
+	rule = EffectiveSplit(rule, members)
+
+	var errs ValidationErrors
+	errs.CheckSplit(s.Price, rule, members)
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	s.Split = rule
+	s.Members = AllocateShares(s.Price, rule, members)
+	return nil
+}
+
+// EffectiveSplit returns the split rule of sharing between members, none without members and equal when not given
+func EffectiveSplit(rule SplitRule, members []Member) SplitRule {
+	switch {
+	case len(members) == 0:
+		return ""
+	case rule == "":
+		return SplitEqual
+	}
+	return rule
+}
+
+// AllocateShares returns members with the amounts they bear of price. Amounts are minor units adding up
+// to the price, the remainder of an equal or percentage split goes to the first members one unit each.
+// The split has to be valid.
+func AllocateShares(price Money, rule SplitRule, members []Member) []Member {
+	if len(members) == 0 {
+		return nil
+	}
+
+	result := make([]Member, len(members))
+	var allocated int64
+	for i, member := range members {
+		switch rule {
+		case SplitEqual:
+			member.Amount = price.Amount / int64(len(members))
+		case SplitPercentage:
+			member.Amount = price.Amount * member.Share / 100
+		case SplitFixed:
+			member.Amount = member.Share
+		}
+		allocated += member.Amount
+		result[i] = member
+	}
+
+	for i := 0; allocated < price.Amount; i++ {
+		result[i%len(result)].Amount++
+		allocated++
+	}
+	return result
+}
+
+// CheckSplit records the errors of sharing price between members by rule
+func (v *ValidationErrors) CheckSplit(price Money, rule SplitRule, members []Member) {
+	if len(members) == 0 {
+		if rule != "" {
+			v.Add("members", "must not be empty for a split")
+		}
+		return
+	}
+
+	if !slices.Contains(AllSplitRules, rule) {
+		v.Add("split", "must be one of equal, percentage, fixed")
+		return
+	}
+	if len(members) > maxMembers {
+		v.Add("members", fmt.Sprintf("must not contain more than %d members", maxMembers))
+	}
+
+	seen := make([]uuid.UUID, 0, len(members))
+	total := NewMoney(0, price.Currency)
+	overflow := false
+	for _, member := range members {
+		switch {
+		case member.UserID == uuid.Nil:
+			v.Add("members", "must have a user_id each")
+		case slices.Contains(seen, member.UserID):
+			v.Add("members", "must not contain a user twice: "+member.UserID.String())
+		}
+		seen = append(seen, member.UserID)
+		sum, err := total.Add(NewMoney(member.Share, price.Currency))
+		overflow = overflow || err != nil
+		total = sum
+	}
+
+	switch rule {
+	case SplitEqual:
+		if slices.ContainsFunc(members, func(m Member) bool { return m.Share != 0 }) {
+			v.Add("members", "must not have shares for an equal split")
+		}
+	case SplitPercentage:
+		if slices.ContainsFunc(members, func(m Member) bool { return m.Share <= 0 || m.Share > 100 }) {
+			v.Add("members", "must have shares between 1 and 100 percent")
+		} else if total.Amount != 100 {
+			v.Add("members", "shares must add up to 100 percent")
+		}
+	case SplitFixed:
+		if slices.ContainsFunc(members, func(m Member) bool { return m.Share <= 0 }) {
+			v.Add("members", "must have positive shares")
+		} else if overflow || total != price {
+			v.Add("members", "shares must add up to the price")
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// members returns members with the given shares and distinct user IDs
+func members(shares ...int64) []Member {
+	result := make([]Member, len(shares))
+	for i, share := range shares {
+		result[i] = Member{UserID: uuid.New(), Share: share}
+	}
+	return result
+}
+
+// reasons returns the reasons of the recorded field errors
+func reasons(errs ValidationErrors) []string {
+	var result []string
+	for _, fieldErr := range errs {
+		result = append(result, fieldErr.Reason)
+	}
+	return result
+}
+
+func TestAllocateShares(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       int64
+		rule        SplitRule
+		shares      []int64
+		wantAmounts []int64
+	}{
+		{
+			name:        "equal without remainder",
+			price:       900,
+			rule:        SplitEqual,
+			shares:      []int64{0, 0, 0},
+			wantAmounts: []int64{300, 300, 300},
+		},
+		{
+			name:        "equal remainder to the first members",
+			price:       1000,
+			rule:        SplitEqual,
+			shares:      []int64{0, 0, 0},
+			wantAmounts: []int64{334, 333, 333},
+		},
+		{
+			name:        "equal remainder of two units",
+			price:       11,
+			rule:        SplitEqual,
+			shares:      []int64{0, 0, 0},
+			wantAmounts: []int64{4, 4, 3},
+		},
+		{
+			name:        "equal price below the member count",
+			price:       2,
+			rule:        SplitEqual,
+			shares:      []int64{0, 0, 0},
+			wantAmounts: []int64{1, 1, 0},
+		},
+		{
+			name:        "percentage remainder to the first members",
+			price:       999,
+			rule:        SplitPercentage,
+			shares:      []int64{50, 25, 25},
+			wantAmounts: []int64{500, 250, 249},
+		},
+		{
+			name:        "percentage of a single member",
+			price:       799,
+			rule:        SplitPercentage,
+			shares:      []int64{100},
+			wantAmounts: []int64{799},
+		},
+		{
+			name:        "fixed shares",
+			price:       1000,
+			rule:        SplitFixed,
+			shares:      []int64{700, 300},
+			wantAmounts: []int64{700, 300},
+		},
+		{
+			name:        "largest price",
+			price:       MaxAmount,
+			rule:        SplitPercentage,
+			shares:      []int64{1, 99},
+			wantAmounts: []int64{MaxAmount/100 + 1, MaxAmount * 99 / 100},
+		},
+		{
+			name:  "no members",
+			price: 1000,
+			rule:  SplitEqual,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := members(tt.shares...)
+
+			got := AllocateShares(NewMoney(tt.price, DefaultCurrency), tt.rule, in)
+
+			var amounts []int64
+			var total int64
+			for i, member := range got {
+				if member.UserID != in[i].UserID || member.Share != in[i].Share {
+					t.Errorf("member %d = %+v, want user and share of %+v", i, member, in[i])
+				}
+				amounts = append(amounts, member.Amount)
+				total += member.Amount
+			}
+			if !reflect.DeepEqual(amounts, tt.wantAmounts) {
+				t.Errorf("amounts = %v, want %v", amounts, tt.wantAmounts)
+			}
+			if len(got) > 0 && total != tt.price {
+				t.Errorf("amounts add up to %d, want %d", total, tt.price)
+			}
+		})
+	}
+}
+
+func TestCheckSplit(t *testing.T) {
+	duplicate := uuid.New()
+
+	tests := []struct {
+		name        string
+		price       int64
+		rule        SplitRule
+		members     []Member
+		wantReasons []string
+	}{
+		{
+			name:    "not shared",
+			price:   1000,
+			members: nil,
+		},
+		{
+			name:        "rule without members",
+			price:       1000,
+			rule:        SplitEqual,
+			wantReasons: []string{"must not be empty for a split"},
+		},
+		{
+			name:        "unknown rule",
+			price:       1000,
+			rule:        "weighted",
+			members:     members(0, 0),
+			wantReasons: []string{"must be one of equal, percentage, fixed"},
+		},
+		{
+			name:    "equal split",
+			price:   1000,
+			rule:    SplitEqual,
+			members: members(0, 0, 0),
+		},
+		{
+			name:        "equal split with shares",
+			price:       1000,
+			rule:        SplitEqual,
+			members:     members(50, 50),
+			wantReasons: []string{"must not have shares for an equal split"},
+		},
+		{
+			name:    "percentages adding up to 100",
+			price:   1000,
+			rule:    SplitPercentage,
+			members: members(1, 99),
+		},
+		{
+			name:        "percentages below 100",
+			price:       1000,
+			rule:        SplitPercentage,
+			members:     members(50, 49),
+			wantReasons: []string{"shares must add up to 100 percent"},
+		},
+		{
+			name:        "percentage above 100",
+			price:       1000,
+			rule:        SplitPercentage,
+			members:     members(101),
+			wantReasons: []string{"must have shares between 1 and 100 percent"},
+		},
+		{
+			name:        "zero percent",
+			price:       1000,
+			rule:        SplitPercentage,
+			members:     members(0, 100),
+			wantReasons: []string{"must have shares between 1 and 100 percent"},
+		},
+		{
+			name:    "fixed shares adding up to the price",
+			price:   1000,
+			rule:    SplitFixed,
+			members: members(999, 1),
+		},
+		{
+			name:        "fixed shares above the price",
+			price:       1000,
+			rule:        SplitFixed,
+			members:     members(999, 2),
+			wantReasons: []string{"shares must add up to the price"},
+		},
+		{
+			name:        "fixed shares overflowing",
+			price:       MaxAmount,
+			rule:        SplitFixed,
+			members:     members(1<<62, 1<<62, 1<<62, MaxAmount),
+			wantReasons: []string{"shares must add up to the price"},
+		},
+		{
+			name:        "negative fixed share",
+			price:       1000,
+			rule:        SplitFixed,
+			members:     members(1001, -1),
+			wantReasons: []string{"must have positive shares"},
+		},
+		{
+			name:        "missing user",
+			price:       1000,
+			rule:        SplitEqual,
+			members:     []Member{{UserID: uuid.New()}, {}},
+			wantReasons: []string{"must have a user_id each"},
+		},
+		{
+			name:        "user twice",
+			price:       1000,
+			rule:        SplitEqual,
+			members:     []Member{{UserID: duplicate}, {UserID: duplicate}},
+			wantReasons: []string{"must not contain a user twice: " + duplicate.String()},
+		},
+		{
+			name:        "too many members",
+			price:       1000,
+			rule:        SplitEqual,
+			members:     members(make([]int64, maxMembers+1)...),
+			wantReasons: []string{"must not contain more than 20 members"},
+		},
+		{
+			name:    "most members",
+			price:   1000,
+			rule:    SplitEqual,
+			members: members(make([]int64, maxMembers)...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+
+			errs.CheckSplit(NewMoney(tt.price, DefaultCurrency), tt.rule, tt.members)
+
+			if got := reasons(errs); !reflect.DeepEqual(got, tt.wantReasons) {
+				t.Errorf("reasons = %q, want %q", got, tt.wantReasons)
+			}
+		})
+	}
+}
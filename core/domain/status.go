@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// Status is the lifecycle state of a subscription in a month, it follows from the dates of the subscription
+type Status string
+
+const (
+	StatusTrial                 Status = "trial"
+	StatusActive                Status = "active"
+	StatusPaused                Status = "paused"
+	StatusCancellationScheduled Status = "cancellation_scheduled"
+	StatusEnded                 Status = "ended"
+)
+
+// maxCancellationReasonLength is the maximum number of characters of a cancellation reason
+const maxCancellationReasonLength = 500
+
+// transitions lists the statuses an operation may move a subscription to from each status.
+// Statuses also change as months pass: trials and pauses end, cancelled subscriptions end.
+// A cancelled subscription is reactivated by moving or removing its end date before it ends.
+var transitions = map[Status][]Status{
+	StatusTrial:                 {StatusPaused, StatusCancellationScheduled, StatusEnded},
+	StatusActive:                {StatusPaused, StatusCancellationScheduled, StatusEnded},
+	StatusPaused:                {StatusActive, StatusCancellationScheduled, StatusEnded},
+	StatusCancellationScheduled: {StatusActive},
+	StatusEnded:                 {},
+}
+
+// Statuses returns all subscription statuses
+func Statuses() []Status {
+	return []Status{StatusTrial, StatusActive, StatusPaused, StatusCancellationScheduled, StatusEnded}
+}
+
+// ParseStatus converts a string to a Status
+func ParseStatus(value string) (Status, error) {
+	status := Status(value)
+	if _, ok := transitions[status]; !ok {
+		return "", NewValidationError("status", fmt.Sprintf("unknown status %q", value))
+	}
+	return status, nil
+}
+
+// CurrentDate returns the current month in the MM-YYYY format
+func CurrentDate() string {
+	now := time.Now()
+	return FormatDate(now.Year(), int(now.Month()))
+}
+
+// Status returns the status of the subscription in the current month
+func (s *Subscription) Status() Status {
+	status, _ := s.StatusAt(CurrentDate())
+	return status
+}
+
+// StatusAt returns the status of the subscription in the month of the provided date.
+// Subscriptions that have not started yet are active, or in trial when they start with one.
+func (s *Subscription) StatusAt(referenceDate string) (Status, error) {
+	if err := ValidateDateFormat(referenceDate); err != nil {
+		return "", err
+	}
+
+	month := monthNumber(referenceDate)
+	switch {
+	case s.EndDate != nil && monthNumber(*s.EndDate) < month:
+		return StatusEnded, nil
+	case s.CancelledAt != nil:
+		return StatusCancellationScheduled, nil
+	}
+
+	for _, pause := range s.Pauses {
+		if pause.covers(month) {
+			return StatusPaused, nil
+		}
+	}
+
+	if s.TrialEndDate != nil && month <= monthNumber(*s.TrialEndDate) {
+		return StatusTrial, nil
+	}
+	return StatusActive, nil
+}
+
+// Cancel ends the subscription with the month of effectiveEndDate, the last month it is charged for.
+// A cancellation can only bring an end date forward, never extend the subscription.
+// A trial is shortened to end with the subscription, pauses and discounts starting after it are dropped.
+func (s *Subscription) Cancel(effectiveEndDate string, reason *string) error {
+	var errs ValidationErrors
+	if errs.CheckDate("end_date", effectiveEndDate) {
+		if monthNumber(effectiveEndDate) < monthNumber(s.StartDate) {
+			errs.Add("end_date", "must not be before start_date")
+		}
+		if s.EndDate != nil && monthNumber(effectiveEndDate) > monthNumber(*s.EndDate) {
+			errs.Add("end_date", "must not be after the current end_date")
+		}
+	}
+	if reason != nil && utf8.RuneCountInString(*reason) > maxCancellationReasonLength {
+		errs.Add("reason", fmt.Sprintf("must be at most %d characters", maxCancellationReasonLength))
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	to := StatusCancellationScheduled
+	if monthNumber(effectiveEndDate) < monthNumber(CurrentDate()) {
+		to = StatusEnded
+	}
+	if err := s.transitionTo(to); err != nil {
+		return err
+	}
+
+	end := monthNumber(effectiveEndDate)
+	if s.TrialEndDate != nil && monthNumber(*s.TrialEndDate) > end {
+		s.TrialEndDate = &effectiveEndDate
+	}
+	pauses := s.Pauses[:0]
+	for _, pause := range s.Pauses {
+		if monthNumber(pause.StartDate) <= end {
+			pauses = append(pauses, pause)
+		}
+	}
+	s.Pauses = pauses
+	discounts := s.Discounts[:0]
+	for _, discount := range s.Discounts {
+		if monthNumber(discount.StartDate) <= end {
+			discounts = append(discounts, discount)
+		}
+	}
+	s.Discounts = discounts
+
+	now := time.Now()
+	s.EndDate = &effectiveEndDate
+	s.CancelledAt = &now
+	s.CancellationReason = reason
+	s.UpdatedAt = now
+
+	return nil
+}
+
+// SetEndDate changes the last month of the subscription, nil removes it. Changing the end date
+// of a cancelled subscription reactivates it, the end date of an ended subscription is final.
+func (s *Subscription) SetEndDate(endDate *string) error {
+	if sameDate(s.EndDate, endDate) {
+		return nil
+	}
+
+	if s.CancelledAt != nil || s.Status() == StatusEnded {
+		if err := s.transitionTo(StatusActive); err != nil {
+			return err
+		}
+		s.CancelledAt = nil
+		s.CancellationReason = nil
+	}
+
+	s.EndDate = endDate
+	return nil
+}
+
+func sameDate(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// RestoreCancellation records the cancellation of a subscription restored from an export,
+// its end date is the last charged month and has to be set
+func (s *Subscription) RestoreCancellation(cancelledAt time.Time, reason *string) error {
+	var errs ValidationErrors
+	if s.EndDate == nil {
+		errs.Add("end_date", "is required for a cancelled subscription")
+	}
+	if reason != nil && utf8.RuneCountInString(*reason) > maxCancellationReasonLength {
+		errs.Add("cancellation_reason", fmt.Sprintf("must be at most %d characters", maxCancellationReasonLength))
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	s.CancelledAt = &cancelledAt
+	s.CancellationReason = reason
+	return nil
+}
+
+// transitionTo checks that an operation may move the subscription from its current status to status
+func (s *Subscription) transitionTo(status Status) error {
+	return s.transitionAt(CurrentDate(), status)
+}
+
+// transitionAt checks that the subscription may move to status from its status in the month of date
+func (s *Subscription) transitionAt(date string, status Status) error {
+	current, err := s.StatusAt(date)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(transitions[current], status) {
+		return nil
+	}
+	return NewTransitionError(current, status)
+}
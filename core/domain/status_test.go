@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionCancel(t *testing.T) {
+	tests := []struct {
+		name     string
+		end      *string
+		date     string
+		wantCode int
+		want     Status
+	}{
+		{
+			name: "open-ended subscription",
+			date: month(2),
+			want: StatusCancellationScheduled,
+		},
+		{
+			name: "bring the end date forward",
+			end:  datePtr(month(6)),
+			date: month(2),
+			want: StatusCancellationScheduled,
+		},
+		{
+			name: "keep the end date",
+			end:  datePtr(month(6)),
+			date: month(6),
+			want: StatusCancellationScheduled,
+		},
+		{
+			name: "end in the past",
+			date: month(-1),
+			want: StatusEnded,
+		},
+		{
+			name:     "later than the end date",
+			end:      datePtr(month(6)),
+			date:     month(7),
+			wantCode: ValidationError,
+		},
+		{
+			name:     "before the start",
+			date:     month(-13),
+			wantCode: ValidationError,
+		},
+		{
+			name:     "ended subscription",
+			end:      datePtr(month(-2)),
+			date:     month(-3),
+			wantCode: ConflictError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{StartDate: month(-12), EndDate: tt.end}
+
+			err := s.Cancel(tt.date, nil)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("Cancel() error = %v, want code %d", err, tt.wantCode)
+			}
+			if tt.wantCode != 0 {
+				return
+			}
+			if s.EndDate == nil || *s.EndDate != tt.date {
+				t.Errorf("EndDate = %v, want %s", s.EndDate, tt.date)
+			}
+			if status := s.Status(); status != tt.want {
+				t.Errorf("Status() = %s, want %s", status, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubscriptionSetEndDate(t *testing.T) {
+	cancelledAt := time.Now()
+
+	tests := []struct {
+		name          string
+		end           *string
+		cancelled     bool
+		newEnd        *string
+		wantCode      int
+		wantCancelled bool
+	}{
+		{
+			name:   "set an end date",
+			newEnd: datePtr(month(3)),
+		},
+		{
+			name:   "remove the end date",
+			end:    datePtr(month(3)),
+			newEnd: nil,
+		},
+		{
+			name:          "unchanged end of a cancelled subscription",
+			end:           datePtr(month(3)),
+			cancelled:     true,
+			newEnd:        datePtr(month(3)),
+			wantCancelled: true,
+		},
+		{
+			name:      "extend a cancelled subscription",
+			end:       datePtr(month(3)),
+			cancelled: true,
+			newEnd:    datePtr(month(9)),
+		},
+		{
+			name:      "remove the end of a cancelled subscription",
+			end:       datePtr(month(3)),
+			cancelled: true,
+			newEnd:    nil,
+		},
+		{
+			name:     "move the end of an ended subscription",
+			end:      datePtr(month(-2)),
+			newEnd:   datePtr(month(-1)),
+			wantCode: ConflictError,
+		},
+		{
+			name:      "remove the end of an ended cancelled subscription",
+			end:       datePtr(month(-2)),
+			cancelled: true,
+			newEnd:    nil,
+			wantCode:  ConflictError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{StartDate: month(-12), EndDate: tt.end}
+			if tt.cancelled {
+				s.CancelledAt = &cancelledAt
+			}
+
+			err := s.SetEndDate(tt.newEnd)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("SetEndDate() error = %v, want code %d", err, tt.wantCode)
+			}
+			if tt.wantCode != 0 {
+				if !reflect.DeepEqual(s.EndDate, tt.end) {
+					t.Errorf("EndDate = %v, want unchanged %v", s.EndDate, tt.end)
+				}
+				return
+			}
+			if !reflect.DeepEqual(s.EndDate, tt.newEnd) {
+				t.Errorf("EndDate = %v, want %v", s.EndDate, tt.newEnd)
+			}
+			if cancelled := s.CancelledAt != nil; cancelled != tt.wantCancelled {
+				t.Errorf("cancelled = %t, want %t", cancelled, tt.wantCancelled)
+			}
+		})
+	}
+}
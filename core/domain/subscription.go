@@ -7,27 +7,41 @@ import (
 
 // Subscription represents the core business entity for user server
 type Subscription struct {
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	EndDate     *string // Format: MM-YYYY, nullable
-	ServiceName string
-	StartDate   string // Format: MM-YYYY
-	Price       int
-	ID          uuid.UUID
-	UserID      uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	CancelledAt  *time.Time // Set when the subscription was cancelled, EndDate is then its effective end
+	EndDate      *string    // Format: MM-YYYY, nullable
+	TrialEndDate *string    // Format: MM-YYYY, last month of the free trial, nullable
+	// CancellationReason is the optional reason given on cancellation
+	CancellationReason *string
+	Pauses             []Pause    // Ordered by start date, paused months are not charged
+	Members            []Member   // Users dividing the price, the paying UserID bears the full price when empty
+	Discounts          []Discount // Ordered by start date, reduce the price in the months they cover
+	Tags               []string   // Normalized free-form labels, e.g. "work" or "family"
+	ServiceName        string
+	Category           string    // e.g. "music" or "cloud", empty when unclassified
+	Split              SplitRule // Empty when the subscription is not shared
+	StartDate          string    // Format: MM-YYYY
+	Price              Money     // Monthly price
+	Tax                Tax       // VAT included in or added to the price
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	ServiceID          *uuid.UUID // Catalog entry of the service, nil for names not in the catalog
 }
 
 // NewSubscription creates a new Subscription with validation
-func NewSubscription(id uuid.UUID, serviceName string, price int, userID uuid.UUID, startDate string, endDate *string) (*Subscription, error) {
+func NewSubscription(id uuid.UUID, serviceName string, price Money, userID uuid.UUID, startDate string, endDate, trialEndDate *string) (*Subscription, error) {
 	sub := &Subscription{
-		ID:          id,
-		ServiceName: serviceName,
-		Price:       price,
-		UserID:      userID,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:           id,
+		ServiceName:  serviceName,
+		Price:        price,
+		UserID:       userID,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		TrialEndDate: trialEndDate,
+		Tax:          DefaultTax,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
 	}
 
 	if err := sub.Validate(); err != nil {
@@ -39,26 +53,31 @@ func NewSubscription(id uuid.UUID, serviceName string, price int, userID uuid.UU
 
 // Validate validates the subscription business rules
 func (s *Subscription) Validate() error {
+	var errs ValidationErrors
+
 	if s.ServiceName == "" {
-		return NewValidationError("service name", "service name is required")
+		errs.Add("service_name", "is required")
 	}
 
-	if s.Price <= 0 {
-		return NewValidationError("price", "price must be positive")
-	}
+	errs.CheckPrice("price", s.Price)
+	errs.CheckTax(s.Tax)
 
 	if s.UserID == uuid.Nil {
-		return NewValidationError("user ID", "user ID is required")
+		errs.Add("user_id", "is required")
 	}
 
-	if err := ValidateSubscriptionDates(s.StartDate, s.EndDate); err != nil {
-		return err
-	}
+	errs.CheckPeriod("start_date", s.StartDate, "end_date", s.EndDate)
+	errs.CheckTrial(s.StartDate, s.EndDate, s.TrialEndDate)
+	errs.CheckPauses(s.StartDate, s.EndDate, s.Pauses)
+	errs.CheckClassification(s.Category, s.Tags)
+	errs.CheckSplit(s.Price, s.Split, s.Members)
+	errs.CheckDiscounts(s.StartDate, s.EndDate, s.Discounts)
 
-	return nil
+	return errs.Err()
 }
 
-// IsActive checks if the subscription is currently active based on the provided date
+// IsActive checks if the subscription is currently active based on the provided date,
+// it is not active in paused months
 func (s *Subscription) IsActive(referenceDate string) (bool, error) {
 	if err := ValidateDateFormat(referenceDate); err != nil {
 		return false, err
@@ -71,25 +90,55 @@ func (s *Subscription) IsActive(referenceDate string) (bool, error) {
 		return false, err
 	}
 
-	if s.EndDate == nil {
-		return refAfterStart, nil
+	if !refAfterStart {
+		return false, nil
 	}
 
-	refBeforeEnd, err := isDateBeforeOrEqual(referenceDate, *s.EndDate)
+	if s.EndDate != nil {
+		refBeforeEnd, err := isDateBeforeOrEqual(referenceDate, *s.EndDate)
+		if err != nil || !refBeforeEnd {
+			return false, err
+		}
+	}
+
+	paused, err := s.PausedIn(referenceDate)
 	if err != nil {
 		return false, err
 	}
+	return !paused, nil
+}
+
+// Overlaps checks if both subscriptions are of the same user and service and share at least one month.
+// A user may subscribe to a service again after a previous subscription ended.
+func (s *Subscription) Overlaps(other *Subscription) bool {
+	if s.ID == other.ID || s.UserID != other.UserID || s.ServiceName != other.ServiceName {
+		return false
+	}
+	return periodCovers(s.StartDate, s.EndDate, monthNumber(other.StartDate)) ||
+		periodCovers(other.StartDate, other.EndDate, monthNumber(s.StartDate))
+}
+
+// InTrial checks if the subscription is in its free trial in the month of the provided date
+func (s *Subscription) InTrial(referenceDate string) (bool, error) {
+	if s.TrialEndDate == nil {
+		return false, nil
+	}
+
+	active, err := s.IsActive(referenceDate)
+	if err != nil || !active {
+		return false, err
+	}
 
-	return refAfterStart && refBeforeEnd, nil
+	return isDateBeforeOrEqual(referenceDate, *s.TrialEndDate)
 }
 
 // SubscriptionFactory creates server with generated ID
 type SubscriptionFactory struct{}
 
-func (f *SubscriptionFactory) CreateSubscription(serviceName string, price int, userID uuid.UUID, startDate string, endDate *string) (*Subscription, error) {
+func (f *SubscriptionFactory) CreateSubscription(serviceName string, price Money, userID uuid.UUID, startDate string, endDate, trialEndDate *string) (*Subscription, error) {
 	id := uuid.New()
 
-	sub, err := NewSubscription(id, serviceName, price, userID, startDate, endDate)
+	sub, err := NewSubscription(id, serviceName, price, userID, startDate, endDate, trialEndDate)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// SubscriptionEventType is the kind of change a subscription event records
+type SubscriptionEventType string
+
+const (
+	SubscriptionCreated SubscriptionEventType = "subscription.created"
+	SubscriptionUpdated SubscriptionEventType = "subscription.updated"
+	SubscriptionDeleted SubscriptionEventType = "subscription.deleted"
+)
+
+// SubscriptionEvent records a change of a subscription.
+// IDs grow in commit order within a tenant, so they can be used to resume a stream.
+type SubscriptionEvent struct {
+	OccurredAt time.Time
+	// Subscription is the state after the change, or before it for deletions
+	Subscription *Subscription
+	Type         SubscriptionEventType
+	ID           int64
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "fmt"
+
+// MaxTaxRate is a tax rate of 100% in basis points
+const MaxTaxRate = 10000
+
+// Tax is the VAT charged on the price of a subscription
+type Tax struct {
+	Rate      int  // Basis points, 2000 is 20%
+	Inclusive bool // Price includes the tax, otherwise the tax is added on top of it
+}
+
+// DefaultTax is the tax of subscriptions created without one, their price is what is paid
+var DefaultTax = Tax{Inclusive: true}
+
+// ApplyTax sets the tax rate of the subscription and whether its price includes the tax
+func (s *Subscription) ApplyTax(tax Tax) error {
+	var errs ValidationErrors
+	errs.CheckTax(tax)
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	s.Tax = tax
+	return nil
+}
+
+// CheckTax records the errors of a tax, its rate has to be between 0 and MaxTaxRate
+func (v *ValidationErrors) CheckTax(tax Tax) {
+	if tax.Rate < 0 || tax.Rate > MaxTaxRate {
+		v.Add("tax_rate", fmt.Sprintf("must be between 0 and %d basis points", MaxTaxRate))
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckTax(t *testing.T) {
+	tests := []struct {
+		name        string
+		tax         Tax
+		wantReasons []string
+	}{
+		{name: "no tax", tax: DefaultTax},
+		{name: "inclusive rate", tax: Tax{Rate: 2000, Inclusive: true}},
+		{name: "exclusive rate", tax: Tax{Rate: 750}},
+		{name: "full rate", tax: Tax{Rate: MaxTaxRate}},
+		{name: "above the full rate", tax: Tax{Rate: MaxTaxRate + 1}, wantReasons: []string{"must be between 0 and 10000 basis points"}},
+		{name: "negative rate", tax: Tax{Rate: -1, Inclusive: true}, wantReasons: []string{"must be between 0 and 10000 basis points"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+
+			errs.CheckTax(tt.tax)
+
+			if got := reasons(errs); !reflect.DeepEqual(got, tt.wantReasons) {
+				t.Errorf("reasons = %q, want %q", got, tt.wantReasons)
+			}
+		})
+	}
+}
+
+func TestSubscriptionApplyTax(t *testing.T) {
+	tests := []struct {
+		name     string
+		tax      Tax
+		wantCode int
+		want     Tax
+	}{
+		{name: "valid tax", tax: Tax{Rate: 2000}, want: Tax{Rate: 2000}},
+		{name: "invalid rate keeps the tax", tax: Tax{Rate: MaxTaxRate + 1}, wantCode: ValidationError, want: DefaultTax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{Tax: DefaultTax}
+
+			err := s.ApplyTax(tt.tax)
+
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("ApplyTax() error = %v, want code %d", err, tt.wantCode)
+			}
+			if s.Tax != tt.want {
+				t.Errorf("Tax = %+v, want %+v", s.Tax, tt.want)
+			}
+		})
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"regexp"
 )
 
+// dateFormatReason is reported for dates that are not in the MM-YYYY format
+const dateFormatReason = "must be in MM-YYYY format (e.g., 12-2024)"
+
+var datePattern = regexp.MustCompile(`^(0[1-9]|1[0-2])-20\d{2}$`)
+
 // ValidateDateFormat checks date format MM-YYYY
 func ValidateDateFormat(date string) error {
-	matched, _ := regexp.MatchString(`^(0[1-9]|1[0-2])-20\d{2}$`, date)
-	if !matched {
-		return NewValidationError("date", "must be in MM-YYYY format (e.g., 12-2024)")
+	if !datePattern.MatchString(date) {
+		return NewValidationError("date", dateFormatReason)
 	}
 	return nil
 }
@@ -27,18 +31,71 @@ func ValidateDateRange(startDate, endDate string) error {
 
 // ValidateSubscriptionDates validates startDate and endDate
 func ValidateSubscriptionDates(startDate string, endDate *string) error {
-	if err := ValidateDateFormat(startDate); err != nil {
-		return err
+	var errs ValidationErrors
+	errs.CheckPeriod("start_date", startDate, "end_date", endDate)
+	return errs.Err()
+}
+
+// CheckDate records an error when date is not in the MM-YYYY format and reports whether it is
+func (v *ValidationErrors) CheckDate(field, date string) bool {
+	if !datePattern.MatchString(date) {
+		v.Add(field, dateFormatReason)
+		return false
+	}
+	return true
+}
+
+// CheckPeriod records the errors of a period given as MM-YYYY dates, endDate is optional.
+// The order of the dates is only checked when both of them are well-formed.
+func (v *ValidationErrors) CheckPeriod(startField, startDate, endField string, endDate *string) {
+	startValid := v.CheckDate(startField, startDate)
+	if endDate == nil || !v.CheckDate(endField, *endDate) || !startValid {
+		return
+	}
+
+	if startAfterEnd, err := isDateAfter(startDate, *endDate); err == nil && startAfterEnd {
+		v.Add(endField, "must not be before "+startField)
+	}
+}
+
+// CheckTrial records the errors of a trial end date, the trial has to lie within the subscription period.
+// Malformed subscription dates are reported by CheckPeriod, the trial is then only checked for its format.
+func (v *ValidationErrors) CheckTrial(startDate string, endDate, trialEndDate *string) {
+	if trialEndDate == nil || !v.CheckDate("trial_end_date", *trialEndDate) {
+		return
 	}
 
-	if endDate != nil {
-		if err := ValidateDateFormat(*endDate); err != nil {
-			return err
+	if datePattern.MatchString(startDate) {
+		if before, err := isDateAfter(startDate, *trialEndDate); err == nil && before {
+			v.Add("trial_end_date", "must not be before start_date")
+			return
 		}
-		if err := ValidateDateRange(startDate, *endDate); err != nil {
-			return err
+	}
+	if endDate != nil && datePattern.MatchString(*endDate) {
+		if after, err := isDateAfter(*trialEndDate, *endDate); err == nil && after {
+			v.Add("trial_end_date", "must not be after end_date")
 		}
 	}
+}
+
+// CheckPauses records the errors of pauses, every pause has to start within the subscription period
+// and pauses must not overlap. Pauses are only checked when the subscription dates are well-formed.
+func (v *ValidationErrors) CheckPauses(startDate string, endDate *string, pauses []Pause) {
+	if !datePattern.MatchString(startDate) || (endDate != nil && !datePattern.MatchString(*endDate)) {
+		return
+	}
 
-	return nil
+	for i, pause := range pauses {
+		if !datePattern.MatchString(pause.StartDate) || (pause.EndDate != nil && !datePattern.MatchString(*pause.EndDate)) {
+			v.Add("pauses", dateFormatReason)
+			continue
+		}
+
+		if !periodCovers(startDate, endDate, monthNumber(pause.StartDate)) {
+			v.Add("pauses", "pause starting "+pause.StartDate+" must start within the subscription period")
+		}
+		if i > 0 && pauses[i-1].overlaps(pause) {
+			v.Add("pauses", "pause starting "+pause.StartDate+" overlaps the previous pause")
+		}
+	}
 }
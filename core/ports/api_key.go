@@ -0,0 +1,72 @@
+package ports
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"subscription/core/domain"
+	"time"
+)
+
+// APIKeyRepository defines the interface for API key data operations
+type APIKeyRepository interface {
+	// Create stores a new API key
+	Create(ctx context.Context, key *domain.APIKey) error
+
+	// GetByID returns an API key by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+
+	// GetByPrefix returns an API key by its public prefix
+	GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+
+	// List returns all API keys
+	List(ctx context.Context) ([]*domain.APIKey, error)
+
+	// Update saves the name, scopes and expiration of an API key
+	Update(ctx context.Context, key *domain.APIKey) error
+
+	// Revoke marks an API key as revoked
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+
+	// TouchLastUsed records the time the API key was last used
+	TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+// APIKeyService defines the business logic operations for API keys
+type APIKeyService interface {
+	// CreateAPIKey issues a new API key, the plaintext key is only returned here
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreatedAPIKey, error)
+
+	// GetAPIKey returns an API key by ID
+	GetAPIKey(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+
+	// ListAPIKeys returns all API keys
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+
+	// UpdateAPIKey partially updates an API key
+	UpdateAPIKey(ctx context.Context, id uuid.UUID, req *UpdateAPIKeyRequest) (*domain.APIKey, error)
+
+	// RevokeAPIKey revokes an API key by ID
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+
+	// Authenticate resolves a plaintext API key into a principal
+	Authenticate(ctx context.Context, rawKey string) (*Principal, error)
+}
+
+// CreateAPIKeyRequest represents the request to create an API key
+type CreateAPIKeyRequest struct {
+	ExpiresAt *time.Time           `json:"expires_at"`
+	Name      string               `json:"name" validate:"required"`
+	Scopes    []domain.APIKeyScope `json:"scopes" validate:"required,min=1"`
+}
+
+// UpdateAPIKeyRequest represents the request to partially update an API key
+type UpdateAPIKeyRequest struct {
+	Name   *string              `json:"name" validate:"omitempty"`
+	Scopes []domain.APIKeyScope `json:"scopes" validate:"omitempty"`
+}
+
+// CreatedAPIKey contains a new API key together with its plaintext value
+type CreatedAPIKey struct {
+	Key    *domain.APIKey `json:"api_key"`
+	Secret string         `json:"key"`
+}
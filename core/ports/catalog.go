@@ -0,0 +1,68 @@
+package ports
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"subscription/core/domain"
+)
+
+// CatalogRepository defines the interface for service catalog data operations
+type CatalogRepository interface {
+	// Create stores a new catalog entry, it fails with ErrServiceNameTaken when a name or alias is in use
+	Create(ctx context.Context, service *domain.Service) error
+
+	// GetByID returns a catalog entry by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Service, error)
+
+	// FindByName returns the catalog entry whose name or alias matches name after normalization
+	FindByName(ctx context.Context, name string) (*domain.Service, error)
+
+	// List returns the catalog entries ordered by name, category is optional
+	List(ctx context.Context, category string) ([]*domain.Service, error)
+
+	// Update saves a catalog entry and renames the subscriptions referencing it
+	Update(ctx context.Context, service *domain.Service) error
+
+	// Delete removes a catalog entry, it fails with ErrServiceInUse while subscriptions reference it
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// CatalogService defines the business logic operations for the service catalog
+type CatalogService interface {
+	// CreateService adds an entry to the catalog
+	CreateService(ctx context.Context, req *CreateServiceRequest) (*domain.Service, error)
+
+	// GetService returns a catalog entry by ID
+	GetService(ctx context.Context, id uuid.UUID) (*domain.Service, error)
+
+	// ListServices returns the catalog, optionally limited to a category
+	ListServices(ctx context.Context, category string) ([]*domain.Service, error)
+
+	// UpdateService partially updates a catalog entry
+	UpdateService(ctx context.Context, id uuid.UUID, req *UpdateServiceRequest) (*domain.Service, error)
+
+	// DeleteService removes an entry no subscription references
+	DeleteService(ctx context.Context, id uuid.UUID) error
+}
+
+// CreateServiceRequest represents the request to add a catalog entry
+type CreateServiceRequest struct {
+	// DefaultPrice without currency is in domain.DefaultCurrency
+	DefaultPrice *domain.Money       `json:"default_price" validate:"omitempty"`
+	Name         string              `json:"name" validate:"required"`
+	Category     string              `json:"category" validate:"omitempty"`
+	BillingCycle domain.BillingCycle `json:"billing_cycle" validate:"omitempty"`
+	Aliases      []string            `json:"aliases" validate:"omitempty"`
+}
+
+// UpdateServiceRequest represents the request to partially update a catalog entry.
+// RemoveDefaultPrice clears the default price, DefaultPrice is ignored then.
+// DefaultPrice without currency is in domain.DefaultCurrency.
+type UpdateServiceRequest struct {
+	Name               *string              `json:"name" validate:"omitempty"`
+	Category           *string              `json:"category" validate:"omitempty"`
+	DefaultPrice       *domain.Money        `json:"default_price" validate:"omitempty"`
+	BillingCycle       *domain.BillingCycle `json:"billing_cycle" validate:"omitempty"`
+	Aliases            []string             `json:"aliases" validate:"omitempty"`
+	RemoveDefaultPrice bool                 `json:"-"`
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyRecord represents a stored response for an Idempotency-Key
+type IdempotencyRecord struct {
+	ExpiresAt    time.Time
+	Key          string
+	Route        string
+	RequestHash  string
+	ContentType  string
+	ResponseBody []byte
+	StatusCode   int
+	Completed    bool
+}
+
+// IdempotencyStore defines the interface for idempotency key persistence
+type IdempotencyStore interface {
+	// Reserve claims the key for the route. It returns nil when the key was reserved
+	// by this call, or the existing record when the key is already known.
+	Reserve(ctx context.Context, key, route, requestHash string, ttl time.Duration) (*IdempotencyRecord, error)
+
+	// Complete stores the response produced for a reserved key
+	Complete(ctx context.Context, key, route string, statusCode int, contentType string, body []byte) error
+
+	// Release removes a reserved key so that the request can be retried
+	Release(ctx context.Context, key, route string) error
+
+	// DeleteExpired removes all records whose TTL has passed
+	DeleteExpired(ctx context.Context) (int64, error)
+}
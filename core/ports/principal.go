@@ -0,0 +1,64 @@
+package ports
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"slices"
+)
+
+// RoleAdmin allows acting across users and calling administrative endpoints
+const RoleAdmin = "admin"
+
+// PrincipalKind distinguishes end users from service credentials
+type PrincipalKind string
+
+const (
+	PrincipalUser   PrincipalKind = "user"
+	PrincipalAPIKey PrincipalKind = "api_key"
+	// PrincipalSystem is used by operator tools that run without an HTTP request
+	PrincipalSystem PrincipalKind = "system"
+)
+
+// Principal represents the authenticated caller of the current request
+type Principal struct {
+	Kind     PrincipalKind
+	Subject  string
+	Issuer   string
+	Roles    []string
+	TenantID uuid.UUID
+	// Scopes restricts the allowed operations, nil means the principal is not scope-limited
+	Scopes []string
+}
+
+// HasRole checks whether the principal has the given role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// IsAdmin checks whether the principal has the admin role
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin)
+}
+
+// IsService checks whether the principal authenticated with an API key
+func (p *Principal) IsService() bool {
+	return p.Kind == PrincipalAPIKey
+}
+
+// HasScope checks whether the principal is allowed to use the given scope
+func (p *Principal) HasScope(scope string) bool {
+	return p.Scopes == nil || slices.Contains(p.Scopes, scope)
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"subscription/core/domain"
+	"time"
 )
 
 // SubscriptionRepository defines the interface for subscription data operations
@@ -26,12 +27,22 @@ type SubscriptionRepository interface {
 	// Delete removes a subscription by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// GetTotalCost calculates total cost for a period with filters
-	GetTotalCost(ctx context.Context, startMonths, endMonths string, filter SubscriptionFilter) (int, error)
+	// GetTotalCost calculates total cost and discount for a period with filters
+	GetTotalCost(ctx context.Context, startMonths, endMonths string, filter SubscriptionFilter) (*CostTotal, error)
 
-	// SubscriptionExists checks for the existence of a subscription
-	SubscriptionExists(ctx context.Context, userID uuid.UUID, serviceName string) (bool, error)
+	// GetCostBreakdown calculates the cost for a period per category or tag, ordered by cost
+	GetCostBreakdown(ctx context.Context, startDate, endDate string, filter SubscriptionFilter, groupBy CostGroup) ([]CostBreakdownItem, error)
 
-	// GetByUserAndService returns a subscription by user ID and service name
-	GetByUserAndService(ctx context.Context, userID uuid.UUID, serviceName string) (*domain.Subscription, error)
+	// GetActiveStats returns the number and total price of subscriptions active in the given month
+	GetActiveStats(ctx context.Context, month, year int) (*SubscriptionStats, error)
+
+	// ListEvents returns up to limit events with an ID greater than afterID, ordered by ID.
+	// Create, Update, PartialUpdate and Delete record their events in the same transaction as the change.
+	ListEvents(ctx context.Context, afterID int64, filter SubscriptionEventFilter, limit int) ([]*domain.SubscriptionEvent, error)
+
+	// LastEventID returns the ID of the latest event, 0 when there are none
+	LastEventID(ctx context.Context) (int64, error)
+
+	// DeleteEventsBefore removes the events of all tenants that occurred before the given time
+	DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error)
 }
@@ -1,6 +1,10 @@
 package ports
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+)
 
 // SubscriptionFilter contains filtering criteria for server
 type SubscriptionFilter struct {
@@ -9,6 +13,24 @@ type SubscriptionFilter struct {
 	EndDateNull   *bool       `json:"end_date_null" validate:"omitempty"`
 	UserIDs       []uuid.UUID `json:"user_ids" validate:"omitempty,dive,uuid4"`
 	ServiceNames  []string    `json:"service_names" validate:"omitempty"`
+	// TrialEndFrom and TrialEndTo select subscriptions whose trial ends within the months, both are inclusive
+	TrialEndFrom *string `json:"trial_end_from" validate:"omitempty,mm_yyyy_format"`
+	TrialEndTo   *string `json:"trial_end_to" validate:"omitempty,mm_yyyy_format"`
+	// Statuses selects subscriptions in any of the statuses in the current month
+	Statuses []domain.Status `json:"statuses" validate:"omitempty"`
+	// Categories selects subscriptions in any of the categories, Tags those with any of the tags
+	Categories []string `json:"categories" validate:"omitempty"`
+	Tags       []string `json:"tags" validate:"omitempty"`
+	// Attribution decides how the cost of shared subscriptions is attributed to UserIDs, it only applies to costs
+	Attribution CostAttribution `json:"attribution" validate:"omitempty"`
+	// Currency selects the subscriptions priced in it, it only applies to costs, amounts in other currencies are not added up
+	Currency domain.Currency `json:"currency" validate:"omitempty"`
+}
+
+// SubscriptionEventFilter selects the events of a subscription event stream
+type SubscriptionEventFilter struct {
+	UserIDs      []uuid.UUID `json:"user_ids" validate:"omitempty,dive,uuid4"`
+	ServiceNames []string    `json:"service_names" validate:"omitempty"`
 }
 
 // Pagination contains pagination parameters
@@ -24,3 +46,21 @@ type PaginationMetadata struct {
 	Total      int `json:"total"`
 	TotalPages int `json:"total_pages"`
 }
+
+// CostTotal is the cost of subscriptions over a period in minor units, TotalCost is charged after Discount was taken off.
+// TotalCost includes Tax and NetCost is TotalCost without it.
+type CostTotal struct {
+	TotalCost int64 `json:"total_cost"`
+	NetCost   int64 `json:"net_cost"`
+	Tax       int64 `json:"tax"`
+	Discount  int64 `json:"discount"`
+}
+
+// SubscriptionStats contains aggregated figures of active subscriptions, paused ones are not active
+type SubscriptionStats struct {
+	ActiveCount int64 `json:"active_count"`
+	// MonthlySpend is the spend after discounts including tax per currency, ordered by currency
+	MonthlySpend []domain.Money `json:"monthly_spend"`
+	// TrialCount is the number of active subscriptions in their free trial, they are not part of MonthlySpend
+	TrialCount int64 `json:"trial_count"`
+}
@@ -26,6 +26,26 @@ type SubscriptionService interface {
 	// DeleteSubscription removes a subscription by ID
 	DeleteSubscription(ctx context.Context, id uuid.UUID) error
 
-	// GetTotalCost calculates total subscription cost for period
+	// PauseSubscription suspends a subscription for an interval of months
+	PauseSubscription(ctx context.Context, id uuid.UUID, req *PauseSubscriptionRequest) (*domain.Subscription, error)
+
+	// ResumeSubscription ends the pause covering the requested month
+	ResumeSubscription(ctx context.Context, id uuid.UUID, req *ResumeSubscriptionRequest) (*domain.Subscription, error)
+
+	// CancelSubscription ends a subscription with an effective end month and records the reason
+	CancelSubscription(ctx context.Context, id uuid.UUID, req *CancelSubscriptionRequest) (*domain.Subscription, error)
+
+	// ListEndingTrials returns the subscriptions whose free trial ends within the given number of months,
+	// the current month counts as the first one
+	ListEndingTrials(ctx context.Context, withinMonths int, filter SubscriptionFilter, pagination Pagination) ([]*domain.Subscription, *PaginationMetadata, error)
+
+	// GetTotalCost calculates total subscription cost for period, trial and paused months are free
+	// and discounts are taken off, only subscriptions priced in the requested currency are added up
 	GetTotalCost(ctx context.Context, req *TotalCostRequest) (*TotalCostResponse, error)
+
+	// ListSubscriptionEvents returns up to limit events after afterID that the caller may read
+	ListSubscriptionEvents(ctx context.Context, afterID int64, filter SubscriptionEventFilter, limit int) ([]*domain.SubscriptionEvent, error)
+
+	// LastSubscriptionEventID returns the ID of the latest event, streams without a resume point start after it
+	LastSubscriptionEventID(ctx context.Context) (int64, error)
 }
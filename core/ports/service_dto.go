@@ -1,32 +1,127 @@
 package ports
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+)
 
 // CreateSubscriptionRequest represents the request to create a subscription
 type CreateSubscriptionRequest struct {
-	EndDate     *string   `json:"end_date" validate:"omitempty,mm_yyyy_format"`
-	ServiceName string    `json:"service_name" validate:"required"`
-	StartDate   string    `json:"start_date" validate:"required,mm_yyyy_format"`
-	Price       int       `json:"price" validate:"required,min=1"`
-	UserID      uuid.UUID `json:"user_id" validate:"required,uuid4"`
+	EndDate      *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+	TrialEndDate *string `json:"trial_end_date" validate:"omitempty,mm_yyyy_format"`
+	ServiceName  string  `json:"service_name" validate:"required"`
+	StartDate    string  `json:"start_date" validate:"required,mm_yyyy_format"`
+	// Price with a zero amount takes the default price of the catalog entry, without currency it is in domain.DefaultCurrency
+	Price  domain.Money `json:"price" validate:"omitempty"`
+	UserID uuid.UUID    `json:"user_id" validate:"required,uuid4"`
+	// TaxRate is the VAT rate in basis points, TaxInclusive nil takes the price as tax-inclusive
+	TaxRate      int   `json:"tax_rate" validate:"omitempty,min=0,max=10000"`
+	TaxInclusive *bool `json:"tax_inclusive" validate:"omitempty"`
+	// Category empty takes the category of the catalog entry
+	Category string   `json:"category" validate:"omitempty,max=100"`
+	Tags     []string `json:"tags" validate:"omitempty"`
+	// Members share the price by Split, the subscription is not shared without members
+	Split   domain.SplitRule `json:"split" validate:"omitempty,oneof=equal percentage fixed"`
+	Members []MemberShare    `json:"members" validate:"omitempty,dive"`
+	// Discounts reduce the price in the months they cover
+	Discounts []DiscountRule `json:"discounts" validate:"omitempty,dive"`
+	// Pauses, CancelledAt and CancellationReason restore an exported subscription, unlike
+	// PauseSubscription and CancelSubscription they accept months in the past
+	Pauses             []PausePeriod `json:"pauses" validate:"omitempty,dive"`
+	CancelledAt        *time.Time    `json:"cancelled_at" validate:"omitempty"`
+	CancellationReason *string       `json:"cancellation_reason" validate:"omitempty,max=500"`
 }
 
 // UpdateSubscriptionRequest represents the request to update a subscription
 type UpdateSubscriptionRequest struct {
-	EndDate     *string   `json:"end_date" validate:"omitempty,mm_yyyy_format"`
-	ServiceName string    `json:"service_name" validate:"required"`
-	StartDate   string    `json:"start_date" validate:"required,mm_yyyy_format"`
-	Price       int       `json:"price" validate:"required,min=1"`
-	UserID      uuid.UUID `json:"user_id" validate:"required,uuid4"`
+	EndDate      *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+	TrialEndDate *string `json:"trial_end_date" validate:"omitempty,mm_yyyy_format"`
+	ServiceName  string  `json:"service_name" validate:"required"`
+	StartDate    string  `json:"start_date" validate:"required,mm_yyyy_format"`
+	// Price with a zero amount takes the default price of the catalog entry, without currency it is in domain.DefaultCurrency
+	Price  domain.Money `json:"price" validate:"omitempty"`
+	UserID uuid.UUID    `json:"user_id" validate:"required,uuid4"`
+	// TaxRate is the VAT rate in basis points, TaxInclusive nil takes the price as tax-inclusive
+	TaxRate      int   `json:"tax_rate" validate:"omitempty,min=0,max=10000"`
+	TaxInclusive *bool `json:"tax_inclusive" validate:"omitempty"`
+	// Category empty takes the category of the catalog entry
+	Category string   `json:"category" validate:"omitempty,max=100"`
+	Tags     []string `json:"tags" validate:"omitempty"`
+	// Members share the price by Split, the subscription is not shared without members
+	Split   domain.SplitRule `json:"split" validate:"omitempty,oneof=equal percentage fixed"`
+	Members []MemberShare    `json:"members" validate:"omitempty,dive"`
+	// Discounts reduce the price in the months they cover
+	Discounts []DiscountRule `json:"discounts" validate:"omitempty,dive"`
 }
 
 // PartialUpdateRequest represents the request for partial update
 type PartialUpdateRequest struct {
-	ServiceName *string    `json:"service_name" validate:"omitempty"`
-	Price       *int       `json:"price" validate:"omitempty,min=1"`
-	UserID      *uuid.UUID `json:"user_id" validate:"omitempty,uuid4"`
-	StartDate   *string    `json:"start_date" validate:"omitempty,mm_yyyy_format"`
-	EndDate     *string    `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+	ServiceName *string `json:"service_name" validate:"omitempty"`
+	// Price without currency keeps the current currency
+	Price  *domain.Money `json:"price" validate:"omitempty"`
+	UserID *uuid.UUID    `json:"user_id" validate:"omitempty,uuid4"`
+	// TaxRate in basis points and TaxInclusive change the tax when set
+	TaxRate      *int    `json:"tax_rate" validate:"omitempty,min=0,max=10000"`
+	TaxInclusive *bool   `json:"tax_inclusive" validate:"omitempty"`
+	StartDate    *string `json:"start_date" validate:"omitempty,mm_yyyy_format"`
+	EndDate      *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+	// TrialEndDate set to an empty string removes the trial
+	TrialEndDate *string `json:"trial_end_date" validate:"omitempty,mm_yyyy_format"`
+	Category     *string `json:"category" validate:"omitempty,max=100"`
+	// Tags replace the current tags when not nil, an empty slice removes them
+	Tags  []string          `json:"tags" validate:"omitempty"`
+	Split *domain.SplitRule `json:"split" validate:"omitempty,oneof=equal percentage fixed"`
+	// Members replace the current members when not nil, an empty slice ends the sharing
+	Members []MemberShare `json:"members" validate:"omitempty,dive"`
+	// Discounts replace the current discounts when not nil, an empty slice removes them
+	Discounts []DiscountRule `json:"discounts" validate:"omitempty,dive"`
+}
+
+// MemberShare is a user sharing a subscription, Share is read according to the split rule
+type MemberShare struct {
+	UserID uuid.UUID `json:"user_id" validate:"required,uuid4"`
+	Share  int64     `json:"share" validate:"omitempty,min=0"`
+}
+
+// DiscountRule is a promotion of a subscription, Value is read according to Kind
+type DiscountRule struct {
+	Kind      domain.DiscountKind `json:"kind" validate:"required,oneof=percentage fixed"`
+	Value     int64               `json:"value" validate:"required,min=1"`
+	StartDate string              `json:"start_date" validate:"required,mm_yyyy_format"`
+	// EndDate nil lets the discount last until the subscription ends
+	EndDate *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+}
+
+// PausePeriod is a pause of a restored subscription
+type PausePeriod struct {
+	StartDate string `json:"start_date" validate:"required,mm_yyyy_format"`
+	// EndDate nil lets the pause last until the subscription is resumed
+	EndDate *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+}
+
+// PauseSubscriptionRequest represents the request to pause a subscription
+type PauseSubscriptionRequest struct {
+	// StartDate is the first paused month, the current month when empty
+	StartDate string `json:"start_date" validate:"omitempty,mm_yyyy_format"`
+	// EndDate is the last paused month, the pause lasts until the subscription is resumed when nil
+	EndDate *string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
+}
+
+// ResumeSubscriptionRequest represents the request to resume a paused subscription
+type ResumeSubscriptionRequest struct {
+	// Date is the first month charged again, the current month when empty
+	Date string `json:"date" validate:"omitempty,mm_yyyy_format"`
+}
+
+// CancelSubscriptionRequest represents the request to cancel a subscription
+type CancelSubscriptionRequest struct {
+	// Reason is optional, it is recorded with the cancellation
+	Reason *string `json:"reason" validate:"omitempty,max=500"`
+	// EndDate is the last charged month, the current month when empty
+	EndDate string `json:"end_date" validate:"omitempty,mm_yyyy_format"`
 }
 
 // TotalCostRequest represents the request for total cost calculation
@@ -35,13 +130,58 @@ type TotalCostRequest struct {
 	EndDate      string      `json:"end_date" validate:"required,mm_yyyy_format"`
 	UserIDs      []uuid.UUID `json:"user_ids" validate:"omitempty,dive,uuid4"`
 	ServiceNames []string    `json:"service_names" validate:"omitempty"`
+	Categories   []string    `json:"categories" validate:"omitempty"`
+	Tags         []string    `json:"tags" validate:"omitempty"`
+	// GroupBy adds a breakdown of the total cost when set
+	GroupBy CostGroup `json:"group_by" validate:"omitempty,oneof=category tag"`
+	// Attribution decides who bears the cost of shared subscriptions, AttributeShares when empty
+	Attribution CostAttribution `json:"attribution" validate:"omitempty,oneof=share payer"`
+	// Currency selects the subscriptions priced in it, domain.DefaultCurrency when empty
+	Currency domain.Currency `json:"currency" validate:"omitempty,len=3"`
 }
 
+// CostAttribution decides how the cost of shared subscriptions is attributed to the filtered users
+type CostAttribution string
+
+const (
+	// AttributeShares charges every member its share and the payer only its own share
+	AttributeShares CostAttribution = "share"
+	// AttributePayer charges the paying user the full price
+	AttributePayer CostAttribution = "payer"
+)
+
+// CostGroup selects what the total cost is broken down by
+type CostGroup string
+
+const (
+	CostByCategory CostGroup = "category"
+	// CostByTag counts a subscription under each of its tags, so the parts may add up to more than the total
+	CostByTag CostGroup = "tag"
+)
+
 // TotalCostResponse represents the response for total cost calculation
 type TotalCostResponse struct {
 	Period         Period                  `json:"period"`
 	FilterCriteria TotalCostFilterCriteria `json:"filter_criteria"`
-	TotalCost      int                     `json:"total_cost"`
+	// TotalCost is the charged cost after discounts including tax, NetCost + Tax, Discount the amount
+	// the discounts saved, all in minor units of Currency
+	TotalCost int64           `json:"total_cost"`
+	NetCost   int64           `json:"net_cost"`
+	Tax       int64           `json:"tax"`
+	Discount  int64           `json:"discount"`
+	Currency  domain.Currency `json:"currency"`
+	// Breakdown is ordered by cost, highest first, and only set when grouping was requested
+	Breakdown []CostBreakdownItem `json:"breakdown,omitempty"`
+}
+
+// CostBreakdownItem is the cost of the subscriptions in a category or with a tag,
+// Key is empty for unclassified subscriptions
+type CostBreakdownItem struct {
+	Key       string `json:"key"`
+	TotalCost int64  `json:"total_cost"`
+	NetCost   int64  `json:"net_cost"`
+	Tax       int64  `json:"tax"`
+	Discount  int64  `json:"discount"`
 }
 
 // Period represents a date period
@@ -52,6 +192,9 @@ type Period struct {
 
 // TotalCostFilterCriteria represents filter criteria used in total cost calculation
 type TotalCostFilterCriteria struct {
-	UserIDs      []uuid.UUID `json:"user_ids"`
-	ServiceNames []string    `json:"service_names"`
+	UserIDs      []uuid.UUID     `json:"user_ids"`
+	ServiceNames []string        `json:"service_names"`
+	Categories   []string        `json:"categories"`
+	Tags         []string        `json:"tags"`
+	Attribution  CostAttribution `json:"attribution"`
 }
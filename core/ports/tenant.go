@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type tenantScopeDisabledKey struct{}
+
+// TenantFromContext returns the tenant of the principal stored in ctx
+func TenantFromContext(ctx context.Context) (uuid.UUID, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.TenantID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return principal.TenantID, true
+}
+
+// WithoutTenantScope marks ctx for system operations that intentionally span all tenants,
+// such as background cleanup or credential lookup before the tenant is known
+func WithoutTenantScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantScopeDisabledKey{}, true)
+}
+
+// IsTenantScopeDisabled reports whether ctx was created by WithoutTenantScope
+func IsTenantScopeDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(tenantScopeDisabledKey{}).(bool)
+	return disabled
+}
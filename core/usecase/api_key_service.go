@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"time"
+)
+
+const (
+	apiKeyTokenPrefix = "sk_"
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 32
+
+	// apiKeyTouchInterval throttles last-used updates to one write per interval
+	apiKeyTouchInterval = time.Minute
+)
+
+type apiKeyService struct {
+	repo ports.APIKeyRepository
+}
+
+func NewAPIKeyService(repo ports.APIKeyRepository) ports.APIKeyService {
+	return &apiKeyService{repo: repo}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, req *ports.CreateAPIKeyRequest) (*ports.CreatedAPIKey, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	prefix, secret, err := generateAPIKey()
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	key, err := domain.NewAPIKey(uuid.New(), req.Name, prefix, hashAPIKey(secret), req.Scopes, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &ports.CreatedAPIKey{Key: key, Secret: secret}, nil
+}
+
+func (s *apiKeyService) GetAPIKey(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.List(ctx)
+}
+
+func (s *apiKeyService) UpdateAPIKey(ctx context.Context, id uuid.UUID, req *ports.UpdateAPIKeyRequest) (*domain.APIKey, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	key, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		key.Name = *req.Name
+	}
+	if req.Scopes != nil {
+		key.Scopes = req.Scopes
+	}
+
+	if err = key.Validate(); err != nil {
+		return nil, err
+	}
+
+	key.UpdatedAt = time.Now()
+	if err = s.repo.Update(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	return s.repo.Revoke(ctx, id, time.Now())
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*ports.Principal, error) {
+	prefix, ok := parseAPIKeyPrefix(rawKey)
+	if !ok {
+		return nil, domain.ErrUnauthorized
+	}
+
+	key, err := s.repo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, domain.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hashAPIKey(rawKey))) != 1 {
+		return nil, domain.ErrUnauthorized
+	}
+
+	now := time.Now()
+	if !key.IsActive(now) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err = s.repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return apiKeyPrincipal(key), nil
+}
+
+// apiKeyPrincipal maps API key scopes to a service principal
+func apiKeyPrincipal(key *domain.APIKey) *ports.Principal {
+	principal := &ports.Principal{
+		Kind:     ports.PrincipalAPIKey,
+		Subject:  "apikey:" + key.ID.String(),
+		TenantID: key.TenantID,
+		Scopes:   make([]string, len(key.Scopes)),
+	}
+
+	for i, scope := range key.Scopes {
+		principal.Scopes[i] = string(scope)
+	}
+
+	if key.HasScope(domain.ScopeAdmin) {
+		principal.Roles = []string{ports.RoleAdmin}
+	}
+
+	return principal
+}
+
+// generateAPIKey returns the lookup prefix and the full plaintext key "sk_<prefix>_<secret>"
+func generateAPIKey() (prefix, key string, err error) {
+	prefixBytes := make([]byte, apiKeyPrefixBytes)
+	if _, err = rand.Read(prefixBytes); err != nil {
+		return "", "", err
+	}
+
+	secretBytes := make([]byte, apiKeySecretBytes)
+	if _, err = rand.Read(secretBytes); err != nil {
+		return "", "", err
+	}
+
+	prefix = hex.EncodeToString(prefixBytes)
+	key = apiKeyTokenPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	return prefix, key, nil
+}
+
+// parseAPIKeyPrefix extracts the lookup prefix from a plaintext key
+func parseAPIKeyPrefix(rawKey string) (string, bool) {
+	rest, ok := strings.CutPrefix(rawKey, apiKeyTokenPrefix)
+	if !ok {
+		return "", false
+	}
+
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != hex.EncodedLen(apiKeyPrefixBytes) || secret == "" {
+		return "", false
+	}
+
+	return prefix, true
+}
+
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
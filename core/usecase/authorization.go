@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"subscription/core/domain"
+	"subscription/core/ports"
+)
+
+// accessScope describes which users' subscriptions the caller may access
+type accessScope struct {
+	userID   uuid.UUID
+	allUsers bool
+}
+
+// authorize checks that the principal stored in ctx may use the scope
+// and resolves which users' subscriptions it may access
+func authorize(ctx context.Context, scope domain.APIKeyScope) (accessScope, error) {
+	principal, ok := ports.PrincipalFromContext(ctx)
+	if !ok {
+		return accessScope{}, domain.ErrUnauthorized
+	}
+
+	if !principal.HasScope(string(scope)) {
+		return accessScope{}, domain.ErrForbidden
+	}
+
+	// Admins and service principals act across users
+	if principal.IsAdmin() || principal.IsService() {
+		return accessScope{allUsers: true}, nil
+	}
+
+	// Regular principals own subscriptions whose UserID equals their subject
+	userID, err := uuid.Parse(principal.Subject)
+	if err != nil || userID == uuid.Nil {
+		return accessScope{}, domain.ErrForbidden
+	}
+
+	return accessScope{userID: userID}, nil
+}
+
+// canAccessUser checks that the scope allows acting on behalf of userID
+func (s accessScope) canAccessUser(userID uuid.UUID) bool {
+	return s.allUsers || s.userID == userID
+}
+
+// authorizeUser checks that the caller may use the scope on subscriptions of userID
+func authorizeUser(ctx context.Context, scope domain.APIKeyScope, userID uuid.UUID) error {
+	access, err := authorize(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	if !access.canAccessUser(userID) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// restrictUserIDs limits a user ID filter to the users visible to the caller
+func restrictUserIDs(ctx context.Context, scope domain.APIKeyScope, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	access, err := authorize(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.allUsers {
+		return userIDs, nil
+	}
+
+	if len(userIDs) == 0 {
+		return []uuid.UUID{access.userID}, nil
+	}
+
+	for _, userID := range userIDs {
+		if !access.canAccessUser(userID) {
+			return nil, domain.ErrForbidden
+		}
+	}
+	return userIDs, nil
+}
+
+// requireAdmin checks that the principal stored in ctx has the admin role
+func requireAdmin(ctx context.Context) error {
+	principal, ok := ports.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrUnauthorized
+	}
+
+	if !principal.IsAdmin() {
+		return domain.ErrForbidden
+	}
+	return nil
+}
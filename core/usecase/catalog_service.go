@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"time"
+)
+
+type catalogService struct {
+	repo ports.CatalogRepository
+}
+
+func NewCatalogService(repo ports.CatalogRepository) ports.CatalogService {
+	return &catalogService{repo: repo}
+}
+
+func (s *catalogService) CreateService(ctx context.Context, req *ports.CreateServiceRequest) (_ *domain.Service, err error) {
+	ctx, span := startSpan(ctx, "catalogService.CreateService", attribute.String("service.name", req.Name))
+	defer func() { endSpan(span, err) }()
+
+	if err = requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var defaultPrice *domain.Money
+	if req.DefaultPrice != nil {
+		price := withCurrency(*req.DefaultPrice, domain.DefaultCurrency)
+		defaultPrice = &price
+	}
+
+	service, err := domain.NewService(uuid.New(), req.Name, req.Aliases, req.Category, defaultPrice, req.BillingCycle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Create(ctx, service); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (s *catalogService) GetService(ctx context.Context, id uuid.UUID) (_ *domain.Service, err error) {
+	ctx, span := startSpan(ctx, "catalogService.GetService", attribute.String("service.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	if _, err = authorize(ctx, domain.ScopeRead); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *catalogService) ListServices(ctx context.Context, category string) (_ []*domain.Service, err error) {
+	ctx, span := startSpan(ctx, "catalogService.ListServices", attribute.String("service.category", category))
+	defer func() { endSpan(span, err) }()
+
+	if _, err = authorize(ctx, domain.ScopeRead); err != nil {
+		return nil, err
+	}
+
+	return s.repo.List(ctx, category)
+}
+
+func (s *catalogService) UpdateService(ctx context.Context, id uuid.UUID, req *ports.UpdateServiceRequest) (_ *domain.Service, err error) {
+	ctx, span := startSpan(ctx, "catalogService.UpdateService", attribute.String("service.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	if err = requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	service, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		service.Name = *req.Name
+	}
+	if req.Aliases != nil {
+		service.Aliases = req.Aliases
+	}
+	if req.Category != nil {
+		service.Category = *req.Category
+	}
+	switch {
+	case req.RemoveDefaultPrice:
+		service.DefaultPrice = nil
+	case req.DefaultPrice != nil:
+		price := withCurrency(*req.DefaultPrice, domain.DefaultCurrency)
+		service.DefaultPrice = &price
+	}
+	if req.BillingCycle != nil {
+		service.BillingCycle = *req.BillingCycle
+	}
+
+	// NewService trims the names, keep the stored entry in the same shape
+	updated, err := domain.NewService(service.ID, service.Name, service.Aliases, service.Category, service.DefaultPrice, service.BillingCycle)
+	if err != nil {
+		return nil, err
+	}
+	updated.TenantID = service.TenantID
+	updated.CreatedAt = service.CreatedAt
+	updated.UpdatedAt = time.Now()
+
+	if err = s.repo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *catalogService) DeleteService(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := startSpan(ctx, "catalogService.DeleteService", attribute.String("service.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	if err = requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, id)
+}
@@ -2,36 +2,87 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"slices"
+	"strings"
 	"subscription/core/domain"
 	"time"
 
 	"subscription/core/ports"
 )
 
+// maxTrialLookahead is the number of months ListEndingTrials may look ahead
+const maxTrialLookahead = 12
+
 type subscriptionService struct {
-	repo ports.SubscriptionRepository
+	repo        ports.SubscriptionRepository
+	catalog     ports.CatalogRepository
+	catalogMode domain.CatalogMode
 	// validator could be added here
 }
 
-func NewSubscriptionService(repo ports.SubscriptionRepository) ports.SubscriptionService {
-	return &subscriptionService{repo: repo}
+// NewSubscriptionService creates the subscription service, service names are matched
+// against the catalog according to catalogMode
+func NewSubscriptionService(repo ports.SubscriptionRepository, catalog ports.CatalogRepository, catalogMode domain.CatalogMode) ports.SubscriptionService {
+	return &subscriptionService{repo: repo, catalog: catalog, catalogMode: catalogMode}
 }
 
-func (s *subscriptionService) CreateSubscription(ctx context.Context, req *ports.CreateSubscriptionRequest) (*domain.Subscription, error) {
+func (s *subscriptionService) CreateSubscription(ctx context.Context, req *ports.CreateSubscriptionRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.CreateSubscription",
+		attribute.String("subscription.user_id", req.UserID.String()),
+		attribute.String("subscription.service_name", req.ServiceName),
+	)
+	defer func() { endSpan(span, err) }()
+
+	if err := authorizeUser(ctx, domain.ScopeWrite, req.UserID); err != nil {
+		return nil, err
+	}
+
+	service, err := s.resolveService(ctx, req.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
 
 	subscription, err := domain.NewSubscription(
 		id,
 		req.ServiceName,
-		req.Price,
+		priceOrDefault(req.Price, service),
 		req.UserID,
 		req.StartDate,
 		req.EndDate,
+		req.TrialEndDate,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if service != nil {
+		subscription.UseService(service)
+	}
+	if err = subscription.Classify(categoryOrDefault(req.Category, service), req.Tags); err != nil {
+		return nil, err
+	}
+	if err = subscription.ApplyTax(toTax(req.TaxRate, req.TaxInclusive)); err != nil {
+		return nil, err
+	}
+	if err = subscription.SplitBetween(req.Split, toMembers(req.Members)); err != nil {
+		return nil, err
+	}
+	if err = subscription.ApplyDiscounts(toDiscounts(req.Discounts)); err != nil {
+		return nil, err
+	}
+	if err = subscription.RestorePauses(toPauses(req.Pauses)); err != nil {
+		return nil, err
+	}
+	if req.CancelledAt != nil {
+		if err = subscription.RestoreCancellation(*req.CancelledAt, req.CancellationReason); err != nil {
+			return nil, err
+		}
+	}
 
 	if subscription.ID, err = s.repo.Create(ctx, subscription); err != nil {
 		return nil, err
@@ -40,11 +91,34 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req *ports
 	return subscription, nil
 }
 
-func (s *subscriptionService) GetSubscription(ctx context.Context, id uuid.UUID) (*domain.Subscription, error) {
-	return s.repo.GetByID(ctx, id)
+func (s *subscriptionService) GetSubscription(ctx context.Context, id uuid.UUID) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.GetSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	return s.getAuthorized(ctx, domain.ScopeRead, id)
 }
 
-func (s *subscriptionService) ListSubscriptions(ctx context.Context, filter ports.SubscriptionFilter, pagination ports.Pagination) ([]*domain.Subscription, *ports.PaginationMetadata, error) {
+// getAuthorized returns the subscription if the caller is allowed to access it
+func (s *subscriptionService) getAuthorized(ctx context.Context, scope domain.APIKeyScope, id uuid.UUID) (*domain.Subscription, error) {
+	subscription, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorizeUser(ctx, scope, subscription.UserID); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *subscriptionService) ListSubscriptions(ctx context.Context, filter ports.SubscriptionFilter, pagination ports.Pagination) (_ []*domain.Subscription, _ *ports.PaginationMetadata, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.ListSubscriptions",
+		attribute.Int("pagination.page", pagination.Page),
+		attribute.Int("pagination.limit", pagination.Limit),
+	)
+	defer func() { endSpan(span, err) }()
+
 	if pagination.Page < 1 {
 		pagination.Page = 1
 	}
@@ -55,27 +129,70 @@ func (s *subscriptionService) ListSubscriptions(ctx context.Context, filter port
 	}
 
 	if err := validateFilter(filter); err != nil {
-		return nil, nil, domain.ErrValidationFailed
+		return nil, nil, err
 	}
 
+	userIDs, err := restrictUserIDs(ctx, domain.ScopeRead, filter.UserIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter.UserIDs = userIDs
+
+	if filter.ServiceNames, err = s.canonicalNames(ctx, filter.ServiceNames); err != nil {
+		return nil, nil, err
+	}
+	filter.Tags = domain.NormalizeTags(filter.Tags)
+
 	return s.repo.List(ctx, filter, pagination)
 }
 
-func (s *subscriptionService) UpdateSubscription(ctx context.Context, id uuid.UUID, req *ports.UpdateSubscriptionRequest) (*domain.Subscription, error) {
-	existing, err := s.repo.GetByID(ctx, id)
+func (s *subscriptionService) UpdateSubscription(ctx context.Context, id uuid.UUID, req *ports.UpdateSubscriptionRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.UpdateSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	existing, err := s.getAuthorized(ctx, domain.ScopeWrite, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = domain.ValidateSubscriptionDates(req.StartDate, req.EndDate); err != nil {
-		return nil, domain.ErrInvalidDateRange
+	// Regular principals cannot transfer a subscription to another user
+	if err = authorizeUser(ctx, domain.ScopeWrite, req.UserID); err != nil {
+		return nil, err
+	}
+
+	service, err := s.resolveService(ctx, req.ServiceName)
+	if err != nil {
+		return nil, err
 	}
 
 	existing.ServiceName = req.ServiceName
-	existing.Price = req.Price
+	existing.ServiceID = nil
+	existing.Price = priceOrDefault(req.Price, service)
+	if service != nil {
+		existing.UseService(service)
+	}
+	if err = existing.Classify(categoryOrDefault(req.Category, service), req.Tags); err != nil {
+		return nil, err
+	}
+	if err = existing.ApplyTax(toTax(req.TaxRate, req.TaxInclusive)); err != nil {
+		return nil, err
+	}
+	if err = existing.SplitBetween(req.Split, toMembers(req.Members)); err != nil {
+		return nil, err
+	}
 	existing.UserID = req.UserID
+	if err = existing.SetEndDate(req.EndDate); err != nil {
+		return nil, err
+	}
 	existing.StartDate = req.StartDate
-	existing.EndDate = req.EndDate
+	existing.TrialEndDate = req.TrialEndDate
+	if err = existing.ApplyDiscounts(toDiscounts(req.Discounts)); err != nil {
+		return nil, err
+	}
+
+	if err = existing.Validate(); err != nil {
+		return nil, err
+	}
 
 	if err = s.repo.Update(ctx, existing); err != nil {
 		return nil, err
@@ -84,40 +201,128 @@ func (s *subscriptionService) UpdateSubscription(ctx context.Context, id uuid.UU
 	return existing, nil
 }
 
-func (s *subscriptionService) PartialUpdateSubscription(ctx context.Context, id uuid.UUID, req *ports.PartialUpdateRequest) (*domain.Subscription, error) {
+func (s *subscriptionService) PartialUpdateSubscription(ctx context.Context, id uuid.UUID, req *ports.PartialUpdateRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.PartialUpdateSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	subscription, err := s.getAuthorized(ctx, domain.ScopeWrite, id)
+	if err != nil {
+		return nil, err
+	}
+
 	updates := make(map[string]interface{})
+	var errs domain.ValidationErrors
 
 	if req.ServiceName != nil {
+		if *req.ServiceName == "" {
+			errs.Add("service_name", "must not be empty")
+		}
+
+		service, err := s.resolveService(ctx, *req.ServiceName)
+		if err != nil {
+			return nil, err
+		}
 		updates["service_name"] = *req.ServiceName
+		updates["service_id"] = nil
+		if service != nil {
+			updates["service_name"] = service.Name
+			updates["service_id"] = service.ID
+		}
 	}
 
+	price := subscription.Price
 	if req.Price != nil {
-		updates["price"] = *req.Price
+		price = withCurrency(*req.Price, subscription.Price.Currency)
+		errs.CheckPrice("price", price)
+		updates["price"] = price.Amount
+		updates["currency"] = string(price.Currency)
 	}
 
-	if req.EndDate != nil && *req.EndDate != "" {
-		if err := domain.ValidateDateFormat(*req.EndDate); err != nil {
-			return nil, domain.ErrInvalidDateformat
+	tax := subscription.Tax
+	if req.TaxRate != nil {
+		tax.Rate = *req.TaxRate
+		updates["tax_rate"] = tax.Rate
+	}
+	if req.TaxInclusive != nil {
+		tax.Inclusive = *req.TaxInclusive
+		updates["tax_inclusive"] = tax.Inclusive
+	}
+	errs.CheckTax(tax)
+
+	category, tags := subscription.Category, subscription.Tags
+	if req.Category != nil {
+		category = strings.TrimSpace(*req.Category)
+		updates["category"] = category
+	}
+	if req.Tags != nil {
+		tags = domain.NormalizeTags(req.Tags)
+		updates["tags"] = tags
+	}
+	errs.CheckClassification(category, tags)
 
+	// Amounts of the members follow the price, fixed shares have to add up to it
+	if req.Price != nil || req.Split != nil || req.Members != nil {
+		split, members := subscription.Split, subscription.Members
+		if req.Split != nil {
+			split = *req.Split
 		}
+		if req.Members != nil {
+			members = toMembers(req.Members)
+		}
+		split = domain.EffectiveSplit(split, members)
+		errs.CheckSplit(price, split, members)
+		updates["split"] = string(split)
+		updates["members"] = domain.AllocateShares(price, split, members)
+	}
 
-		endYear, endMonth, err := domain.ParseDate(*req.EndDate)
-		if err != nil {
+	endDate := subscription.EndDate
+	if req.EndDate != nil && *req.EndDate != "" {
+		errs.CheckPeriod("start_date", subscription.StartDate, "end_date", req.EndDate)
+
+		// Moving the end date of a cancelled subscription reactivates it
+		cancelled := subscription.CancelledAt != nil
+		if err = subscription.SetEndDate(req.EndDate); err != nil {
 			return nil, err
 		}
+		if cancelled && subscription.CancelledAt == nil {
+			updates["cancelled_at"] = nil
+			updates["cancellation_reason"] = nil
+		}
 
-		subscription, err := s.repo.GetByID(ctx, id)
-		if err != nil {
-			return nil, err
+		if endYear, endMonth, err := domain.ParseDate(*req.EndDate); err == nil {
+			updates["end_month"] = endMonth
+			updates["end_year"] = endYear
 		}
+		endDate = req.EndDate
+	}
 
-		err = domain.ValidateDateRange(subscription.StartDate, *req.EndDate)
-		if err != nil {
-			return nil, err
+	// The trial has to stay within the subscription when either of them changes
+	trialEndDate := subscription.TrialEndDate
+	if req.TrialEndDate != nil {
+		trialEndDate = nil
+		updates["trial_end_month"] = nil
+		updates["trial_end_year"] = nil
+
+		if *req.TrialEndDate != "" {
+			trialEndDate = req.TrialEndDate
+			if trialYear, trialMonth, err := domain.ParseDate(*req.TrialEndDate); err == nil {
+				updates["trial_end_month"] = trialMonth
+				updates["trial_end_year"] = trialYear
+			}
 		}
+	}
+	errs.CheckTrial(subscription.StartDate, endDate, trialEndDate)
+	errs.CheckPauses(subscription.StartDate, endDate, subscription.Pauses)
 
-		updates["end_month"] = endMonth
-		updates["end_year"] = endYear
+	discounts := subscription.Discounts
+	if req.Discounts != nil {
+		discounts = domain.OrderDiscounts(toDiscounts(req.Discounts))
+		updates["discounts"] = discounts
+	}
+	errs.CheckDiscounts(subscription.StartDate, endDate, discounts)
+
+	if err = errs.Err(); err != nil {
+		return nil, err
 	}
 
 	updates["updated_at"] = time.Now()
@@ -129,8 +334,11 @@ func (s *subscriptionService) PartialUpdateSubscription(ctx context.Context, id
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *subscriptionService) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
-	_, err := s.repo.GetByID(ctx, id)
+func (s *subscriptionService) DeleteSubscription(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.DeleteSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	_, err = s.getAuthorized(ctx, domain.ScopeWrite, id)
 	if err != nil {
 		return err
 	}
@@ -138,30 +346,310 @@ func (s *subscriptionService) DeleteSubscription(ctx context.Context, id uuid.UU
 	return s.repo.Delete(ctx, id)
 }
 
-func (s *subscriptionService) GetTotalCost(ctx context.Context, req *ports.TotalCostRequest) (*ports.TotalCostResponse, error) {
+func (s *subscriptionService) PauseSubscription(ctx context.Context, id uuid.UUID, req *ports.PauseSubscriptionRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.PauseSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	subscription, err := s.getAuthorized(ctx, domain.ScopeWrite, id)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := req.StartDate
+	if startDate == "" {
+		startDate = domain.CurrentDate()
+	}
+	if err = subscription.Pause(startDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *subscriptionService) ResumeSubscription(ctx context.Context, id uuid.UUID, req *ports.ResumeSubscriptionRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.ResumeSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	subscription, err := s.getAuthorized(ctx, domain.ScopeWrite, id)
+	if err != nil {
+		return nil, err
+	}
+
+	date := req.Date
+	if date == "" {
+		date = domain.CurrentDate()
+	}
+	if err = subscription.Resume(date); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *subscriptionService) CancelSubscription(ctx context.Context, id uuid.UUID, req *ports.CancelSubscriptionRequest) (_ *domain.Subscription, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.CancelSubscription", attribute.String("subscription.id", id.String()))
+	defer func() { endSpan(span, err) }()
+
+	subscription, err := s.getAuthorized(ctx, domain.ScopeWrite, id)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate := req.EndDate
+	if endDate == "" {
+		endDate = domain.CurrentDate()
+	}
+	if err = subscription.Cancel(endDate, req.Reason); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *subscriptionService) ListEndingTrials(ctx context.Context, withinMonths int, filter ports.SubscriptionFilter, pagination ports.Pagination) (_ []*domain.Subscription, _ *ports.PaginationMetadata, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.ListEndingTrials", attribute.Int("trial.within_months", withinMonths))
+	defer func() { endSpan(span, err) }()
+
+	if withinMonths < 1 || withinMonths > maxTrialLookahead {
+		return nil, nil, domain.NewValidationError("within_months", fmt.Sprintf("must be between 1 and %d", maxTrialLookahead))
+	}
+
+	now := time.Now()
+	last := time.Date(now.Year(), now.Month()+time.Month(withinMonths-1), 1, 0, 0, 0, 0, time.UTC)
+	from := domain.CurrentDate()
+	to := domain.FormatDate(last.Year(), int(last.Month()))
+	filter.TrialEndFrom = &from
+	filter.TrialEndTo = &to
+
+	return s.ListSubscriptions(ctx, filter, pagination)
+}
+
+func (s *subscriptionService) GetTotalCost(ctx context.Context, req *ports.TotalCostRequest) (_ *ports.TotalCostResponse, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.GetTotalCost",
+		attribute.String("period.start_date", req.StartDate),
+		attribute.String("period.end_date", req.EndDate),
+	)
+	defer func() { endSpan(span, err) }()
+
 	if err := domain.ValidateSubscriptionDates(req.StartDate, &req.EndDate); err != nil {
-		return nil, domain.ErrInvalidDateRange
+		return nil, err
+	}
+	switch req.GroupBy {
+	case "", ports.CostByCategory, ports.CostByTag:
+	default:
+		return nil, domain.NewValidationError("group_by", "must be one of category, tag")
+	}
+
+	attribution := req.Attribution
+	switch attribution {
+	case "":
+		attribution = ports.AttributeShares
+	case ports.AttributeShares, ports.AttributePayer:
+	default:
+		return nil, domain.NewValidationError("attribution", "must be one of share, payer")
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	} else if !currency.Valid() {
+		return nil, domain.NewValidationError("currency", "must be a three-letter ISO 4217 code")
+	}
+
+	userIDs, err := restrictUserIDs(ctx, domain.ScopeAnalytics, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceNames, err := s.canonicalNames(ctx, req.ServiceNames)
+	if err != nil {
+		return nil, err
 	}
 
 	filter := ports.SubscriptionFilter{
-		UserIDs:      req.UserIDs,
-		ServiceNames: req.ServiceNames,
+		UserIDs:      userIDs,
+		ServiceNames: serviceNames,
+		Categories:   req.Categories,
+		Tags:         domain.NormalizeTags(req.Tags),
+		Attribution:  attribution,
+		Currency:     currency,
 	}
 
-	totalCost, err := s.repo.GetTotalCost(ctx, req.StartDate, req.EndDate, filter)
+	cost, err := s.repo.GetTotalCost(ctx, req.StartDate, req.EndDate, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	var breakdown []ports.CostBreakdownItem
+	if req.GroupBy != "" {
+		if breakdown, err = s.repo.GetCostBreakdown(ctx, req.StartDate, req.EndDate, filter, req.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ports.TotalCostResponse{
-		TotalCost: totalCost,
+		TotalCost: cost.TotalCost,
+		NetCost:   cost.NetCost,
+		Tax:       cost.Tax,
+		Discount:  cost.Discount,
+		Currency:  currency,
 		Period: ports.Period{
 			StartDate: req.StartDate,
 			EndDate:   req.EndDate,
 		},
 		FilterCriteria: ports.TotalCostFilterCriteria{
-			UserIDs:      req.UserIDs,
+			UserIDs:      userIDs,
 			ServiceNames: req.ServiceNames,
+			Categories:   req.Categories,
+			Tags:         filter.Tags,
+			Attribution:  attribution,
 		},
+		Breakdown: breakdown,
 	}, nil
 }
+
+func (s *subscriptionService) ListSubscriptionEvents(ctx context.Context, afterID int64, filter ports.SubscriptionEventFilter, limit int) (_ []*domain.SubscriptionEvent, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.ListSubscriptionEvents", attribute.Int64("events.after_id", afterID))
+	defer func() { endSpan(span, err) }()
+
+	if afterID < 0 {
+		return nil, domain.NewValidationError("last_event_id", "must not be negative")
+	}
+	for _, userID := range filter.UserIDs {
+		if userID == uuid.Nil {
+			return nil, domain.NewValidationError("user_ids", "contains invalid UUID format")
+		}
+	}
+
+	userIDs, err := restrictUserIDs(ctx, domain.ScopeRead, filter.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	filter.UserIDs = userIDs
+
+	return s.repo.ListEvents(ctx, afterID, filter, limit)
+}
+
+func (s *subscriptionService) LastSubscriptionEventID(ctx context.Context) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "subscriptionService.LastSubscriptionEventID")
+	defer func() { endSpan(span, err) }()
+
+	if _, err = authorize(ctx, domain.ScopeRead); err != nil {
+		return 0, err
+	}
+
+	return s.repo.LastEventID(ctx)
+}
+
+// resolveService matches a service name against the catalog according to the catalog mode.
+// The entry is nil for names kept as free text, in strict mode unknown names are rejected.
+func (s *subscriptionService) resolveService(ctx context.Context, name string) (*domain.Service, error) {
+	if s.catalogMode == domain.CatalogOff || domain.NormalizeServiceName(name) == "" {
+		return nil, nil
+	}
+
+	service, err := s.catalog.FindByName(ctx, name)
+	if errors.Is(err, domain.ErrServiceNotFound) {
+		if s.catalogMode == domain.CatalogStrict {
+			return nil, domain.NewValidationError("service_name", "unknown service, add it to the catalog first")
+		}
+		return nil, nil
+	}
+	return service, err
+}
+
+// canonicalNames adds the canonical names of catalog entries to a service name filter.
+// The names themselves are kept, subscriptions stored before the catalog may still use them.
+func (s *subscriptionService) canonicalNames(ctx context.Context, names []string) ([]string, error) {
+	if s.catalogMode == domain.CatalogOff || len(names) == 0 {
+		return names, nil
+	}
+
+	result := slices.Clone(names)
+	for _, name := range names {
+		service, err := s.catalog.FindByName(ctx, name)
+		if errors.Is(err, domain.ErrServiceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(result, service.Name) {
+			result = append(result, service.Name)
+		}
+	}
+	return result, nil
+}
+
+// toMembers converts the requested member shares to domain members, amounts are allocated by the split
+func toMembers(shares []ports.MemberShare) []domain.Member {
+	members := make([]domain.Member, len(shares))
+	for i, share := range shares {
+		members[i] = domain.Member{UserID: share.UserID, Share: share.Share}
+	}
+	return members
+}
+
+// toDiscounts converts the requested discount rules to domain discounts
+func toDiscounts(rules []ports.DiscountRule) []domain.Discount {
+	discounts := make([]domain.Discount, len(rules))
+	for i, rule := range rules {
+		discounts[i] = domain.Discount{Kind: rule.Kind, Value: rule.Value, StartDate: rule.StartDate, EndDate: rule.EndDate}
+	}
+	return discounts
+}
+
+// toPauses converts the requested pause periods to domain pauses
+func toPauses(periods []ports.PausePeriod) []domain.Pause {
+	pauses := make([]domain.Pause, len(periods))
+	for i, period := range periods {
+		pauses[i] = domain.Pause{StartDate: period.StartDate, EndDate: period.EndDate}
+	}
+	return pauses
+}
+
+// categoryOrDefault returns the category of the catalog entry when no category was given
+func categoryOrDefault(category string, service *domain.Service) string {
+	if strings.TrimSpace(category) == "" && service != nil {
+		return service.Category
+	}
+	return category
+}
+
+// priceOrDefault returns the default price of the catalog entry when no price was given,
+// a price without currency is in domain.DefaultCurrency
+func priceOrDefault(price domain.Money, service *domain.Service) domain.Money {
+	if price.Amount == 0 && service != nil && service.DefaultPrice != nil {
+		return *service.DefaultPrice
+	}
+	return withCurrency(price, domain.DefaultCurrency)
+}
+
+// toTax returns the tax of a create or update request, prices are tax-inclusive unless told otherwise
+func toTax(rate int, inclusive *bool) domain.Tax {
+	tax := domain.Tax{Rate: rate, Inclusive: domain.DefaultTax.Inclusive}
+	if inclusive != nil {
+		tax.Inclusive = *inclusive
+	}
+	return tax
+}
+
+// withCurrency returns price in currency when it has none
+func withCurrency(price domain.Money, currency domain.Currency) domain.Money {
+	if price.Currency == "" {
+		price.Currency = currency
+	}
+	return price
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"subscription/core/domain"
+)
+
+const tracerName = "subscription/core/usecase"
+
+// startSpan starts a usecase span using the global tracer provider
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the operation result and ends the span.
+// Domain errors below 500 are expected outcomes and do not mark the span as failed.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+
+		var domainErr *domain.DomainError
+		if !errors.As(err, &domainErr) || domainErr.Code >= domain.InternalServerError {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
@@ -1,36 +1,45 @@
 package usecase
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 	"subscription/core/domain"
 	"subscription/core/ports"
 )
 
-// validateFilter validates filter parameters
+// validateFilter validates filter parameters, reporting every invalid one
 func validateFilter(filter ports.SubscriptionFilter) error {
+	var errs domain.ValidationErrors
+
 	for _, userID := range filter.UserIDs {
 		if userID == uuid.Nil {
-			return domain.NewValidationError("user_ids", "contains invalid UUID format")
+			errs.Add("user_ids", "contains invalid UUID format")
+			break
 		}
 	}
 
-	if filter.StartDateFrom != nil {
-		if err := domain.ValidateDateFormat(*filter.StartDateFrom); err != nil {
-			return domain.NewValidationError("start_date_from", "invalid date format, expected MM-YYYY")
-		}
+	switch {
+	case filter.StartDateFrom != nil:
+		errs.CheckPeriod("start_date_from", *filter.StartDateFrom, "start_date_to", filter.StartDateTo)
+	case filter.StartDateTo != nil:
+		errs.CheckDate("start_date_to", *filter.StartDateTo)
 	}
 
-	if filter.StartDateTo != nil {
-		if err := domain.ValidateDateFormat(*filter.StartDateTo); err != nil {
-			return domain.NewValidationError("start_date_to", "invalid date format, expected MM-YYYY")
+	for _, status := range filter.Statuses {
+		if _, err := domain.ParseStatus(string(status)); err != nil {
+			errs.Add("status", "must be one of "+joinStatuses(domain.Statuses()))
+			break
 		}
 	}
 
-	if filter.StartDateFrom != nil && filter.StartDateTo != nil {
-		if err := domain.ValidateDateRange(*filter.StartDateFrom, *filter.StartDateTo); err != nil {
-			return domain.NewValidationError("date_range", "start date cannot be after end date")
-		}
-	}
+	return errs.Err()
+}
 
-	return nil
+func joinStatuses(statuses []domain.Status) string {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+	return strings.Join(names, ", ")
 }
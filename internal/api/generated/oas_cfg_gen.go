@@ -17,6 +17,7 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
+	"^[A-Z]{3}$":      ogenregex.MustCompile("^[A-Z]{3}$"),
 	"^\\d{2}-\\d{4}$": ogenregex.MustCompile("^\\d{2}-\\d{4}$"),
 }
 var (
@@ -0,0 +1,27 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *SubscriptionCreate) setDefaults() {
+	{
+		val := int(0)
+		s.TaxRate.SetTo(val)
+	}
+	{
+		val := bool(true)
+		s.TaxInclusive.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SubscriptionUpdate) setDefaults() {
+	{
+		val := int(0)
+		s.TaxRate.SetTo(val)
+	}
+	{
+		val := bool(true)
+		s.TaxInclusive.SetTo(val)
+	}
+}
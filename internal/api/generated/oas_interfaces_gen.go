@@ -1,10 +1,46 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AdminAPIKeysIDDeleteRes interface {
+	adminAPIKeysIDDeleteRes()
+}
+
+type AdminAPIKeysIDGetRes interface {
+	adminAPIKeysIDGetRes()
+}
+
+type AdminAPIKeysIDPatchRes interface {
+	adminAPIKeysIDPatchRes()
+}
+
+type AdminAPIKeysPostRes interface {
+	adminAPIKeysPostRes()
+}
+
+type ServicesIDDeleteRes interface {
+	servicesIDDeleteRes()
+}
+
+type ServicesIDGetRes interface {
+	servicesIDGetRes()
+}
+
+type ServicesIDPatchRes interface {
+	servicesIDPatchRes()
+}
+
+type ServicesPostRes interface {
+	servicesPostRes()
+}
+
 type SubscriptionsGetRes interface {
 	subscriptionsGetRes()
 }
 
+type SubscriptionsIDCancelPostRes interface {
+	subscriptionsIDCancelPostRes()
+}
+
 type SubscriptionsIDDeleteRes interface {
 	subscriptionsIDDeleteRes()
 }
@@ -17,10 +53,18 @@ type SubscriptionsIDPatchRes interface {
 	subscriptionsIDPatchRes()
 }
 
+type SubscriptionsIDPausePostRes interface {
+	subscriptionsIDPausePostRes()
+}
+
 type SubscriptionsIDPutRes interface {
 	subscriptionsIDPutRes()
 }
 
+type SubscriptionsIDResumePostRes interface {
+	subscriptionsIDResumePostRes()
+}
+
 type SubscriptionsPostRes interface {
 	subscriptionsPostRes()
 }
@@ -28,3 +72,7 @@ type SubscriptionsPostRes interface {
 type SubscriptionsSummaryTotalCostGetRes interface {
 	subscriptionsSummaryTotalCostGetRes()
 }
+
+type SubscriptionsTrialsEndingGetRes interface {
+	subscriptionsTrialsEndingGetRes()
+}
@@ -6,11 +6,25 @@ package api
 type OperationName = string
 
 const (
+	AdminAPIKeysGetOperation                  OperationName = "AdminAPIKeysGet"
+	AdminAPIKeysIDDeleteOperation             OperationName = "AdminAPIKeysIDDelete"
+	AdminAPIKeysIDGetOperation                OperationName = "AdminAPIKeysIDGet"
+	AdminAPIKeysIDPatchOperation              OperationName = "AdminAPIKeysIDPatch"
+	AdminAPIKeysPostOperation                 OperationName = "AdminAPIKeysPost"
+	ServicesGetOperation                      OperationName = "ServicesGet"
+	ServicesIDDeleteOperation                 OperationName = "ServicesIDDelete"
+	ServicesIDGetOperation                    OperationName = "ServicesIDGet"
+	ServicesIDPatchOperation                  OperationName = "ServicesIDPatch"
+	ServicesPostOperation                     OperationName = "ServicesPost"
 	SubscriptionsGetOperation                 OperationName = "SubscriptionsGet"
+	SubscriptionsIDCancelPostOperation        OperationName = "SubscriptionsIDCancelPost"
 	SubscriptionsIDDeleteOperation            OperationName = "SubscriptionsIDDelete"
 	SubscriptionsIDGetOperation               OperationName = "SubscriptionsIDGet"
 	SubscriptionsIDPatchOperation             OperationName = "SubscriptionsIDPatch"
+	SubscriptionsIDPausePostOperation         OperationName = "SubscriptionsIDPausePost"
 	SubscriptionsIDPutOperation               OperationName = "SubscriptionsIDPut"
+	SubscriptionsIDResumePostOperation        OperationName = "SubscriptionsIDResumePost"
 	SubscriptionsPostOperation                OperationName = "SubscriptionsPost"
 	SubscriptionsSummaryTotalCostGetOperation OperationName = "SubscriptionsSummaryTotalCostGet"
+	SubscriptionsTrialsEndingGetOperation     OperationName = "SubscriptionsTrialsEndingGet"
 )
@@ -11,6 +11,76 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+func encodeAdminAPIKeysIDPatchRequest(
+	req *APIKeyPatch,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeAdminAPIKeysPostRequest(
+	req *APIKeyCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeServicesIDPatchRequest(
+	req *ServicePatch,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeServicesPostRequest(
+	req *ServiceCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeSubscriptionsIDCancelPostRequest(
+	req *SubscriptionCancelRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSubscriptionsIDPatchRequest(
 	req *SubscriptionPatch,
 	r *http.Request,
@@ -25,6 +95,20 @@ func encodeSubscriptionsIDPatchRequest(
 	return nil
 }
 
+func encodeSubscriptionsIDPausePostRequest(
+	req *SubscriptionPauseRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSubscriptionsIDPutRequest(
 	req *SubscriptionUpdate,
 	r *http.Request,
@@ -39,6 +123,20 @@ func encodeSubscriptionsIDPutRequest(
 	return nil
 }
 
+func encodeSubscriptionsIDResumePostRequest(
+	req *SubscriptionResumeRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSubscriptionsPostRequest(
 	req *SubscriptionCreate,
 	r *http.Request,
@@ -49,94 +49,348 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/subscriptions"
+		case '/': // Prefix: "/"
 
-			if l := len("/subscriptions"); len(elem) >= l && elem[0:l] == "/subscriptions" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				switch r.Method {
-				case "GET":
-					s.handleSubscriptionsGetRequest([0]string{}, elemIsEscaped, w, r)
-				case "POST":
-					s.handleSubscriptionsPostRequest([0]string{}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "GET,POST")
-				}
-
-				return
+				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'a': // Prefix: "admin/api-keys"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("admin/api-keys"); len(elem) >= l && elem[0:l] == "admin/api-keys" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch r.Method {
+					case "GET":
+						s.handleAdminAPIKeysGetRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleAdminAPIKeysPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
 				}
 				switch elem[0] {
-				case 's': // Prefix: "summary/total-cost"
-					origElem := elem
-					if l := len("summary/total-cost"); len(elem) >= l && elem[0:l] == "summary/total-cost" {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
 					if len(elem) == 0 {
 						// Leaf node.
 						switch r.Method {
+						case "DELETE":
+							s.handleAdminAPIKeysIDDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleSubscriptionsSummaryTotalCostGetRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleAdminAPIKeysIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleAdminAPIKeysIDPatchRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "DELETE,GET,PATCH")
 						}
 
 						return
 					}
 
-					elem = origElem
 				}
-				// Param: "id"
-				// Leaf parameter, slashes are prohibited
-				idx := strings.IndexByte(elem, '/')
-				if idx >= 0 {
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
 					break
 				}
-				args[0] = elem
-				elem = ""
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "DELETE":
-						s.handleSubscriptionsIDDeleteRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					case "GET":
-						s.handleSubscriptionsIDGetRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					case "PATCH":
-						s.handleSubscriptionsIDPatchRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					case "PUT":
-						s.handleSubscriptionsIDPutRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "ervices"
+
+					if l := len("ervices"); len(elem) >= l && elem[0:l] == "ervices" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleServicesGetRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleServicesPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleServicesIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleServicesIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleServicesIDPatchRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "ubscriptions"
+
+					if l := len("ubscriptions"); len(elem) >= l && elem[0:l] == "ubscriptions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleSubscriptionsGetRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleSubscriptionsPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "summary/total-cost"
+							origElem := elem
+							if l := len("summary/total-cost"); len(elem) >= l && elem[0:l] == "summary/total-cost" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleSubscriptionsSummaryTotalCostGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "trials/ending"
+							origElem := elem
+							if l := len("trials/ending"); len(elem) >= l && elem[0:l] == "trials/ending" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleSubscriptionsTrialsEndingGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleSubscriptionsIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleSubscriptionsIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleSubscriptionsIDPatchRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleSubscriptionsIDPutRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSubscriptionsIDCancelPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "pause"
+
+								if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSubscriptionsIDPausePostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "resume"
+
+								if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSubscriptionsIDResumePostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
 					}
 
-					return
 				}
 
 			}
@@ -221,122 +475,426 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/subscriptions"
+		case '/': // Prefix: "/"
 
-			if l := len("/subscriptions"); len(elem) >= l && elem[0:l] == "/subscriptions" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				switch method {
-				case "GET":
-					r.name = SubscriptionsGetOperation
-					r.summary = "List server with filtering"
-					r.operationID = ""
-					r.pathPattern = "/subscriptions"
-					r.args = args
-					r.count = 0
-					return r, true
-				case "POST":
-					r.name = SubscriptionsPostOperation
-					r.summary = "Create a new subscription"
-					r.operationID = ""
-					r.pathPattern = "/subscriptions"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
-				}
+				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'a': // Prefix: "admin/api-keys"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("admin/api-keys"); len(elem) >= l && elem[0:l] == "admin/api-keys" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch method {
+					case "GET":
+						r.name = AdminAPIKeysGetOperation
+						r.summary = "List API keys"
+						r.operationID = ""
+						r.pathPattern = "/admin/api-keys"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = AdminAPIKeysPostOperation
+						r.summary = "Create an API key"
+						r.operationID = ""
+						r.pathPattern = "/admin/api-keys"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
 				switch elem[0] {
-				case 's': // Prefix: "summary/total-cost"
-					origElem := elem
-					if l := len("summary/total-cost"); len(elem) >= l && elem[0:l] == "summary/total-cost" {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
 					if len(elem) == 0 {
 						// Leaf node.
 						switch method {
+						case "DELETE":
+							r.name = AdminAPIKeysIDDeleteOperation
+							r.summary = "Revoke API key"
+							r.operationID = ""
+							r.pathPattern = "/admin/api-keys/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
 						case "GET":
-							r.name = SubscriptionsSummaryTotalCostGetOperation
-							r.summary = "Get total subscription cost"
+							r.name = AdminAPIKeysIDGetOperation
+							r.summary = "Get API key by ID"
 							r.operationID = ""
-							r.pathPattern = "/subscriptions/summary/total-cost"
+							r.pathPattern = "/admin/api-keys/{id}"
 							r.args = args
-							r.count = 0
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = AdminAPIKeysIDPatchOperation
+							r.summary = "Update API key"
+							r.operationID = ""
+							r.pathPattern = "/admin/api-keys/{id}"
+							r.args = args
+							r.count = 1
 							return r, true
 						default:
 							return
 						}
 					}
 
-					elem = origElem
 				}
-				// Param: "id"
-				// Leaf parameter, slashes are prohibited
-				idx := strings.IndexByte(elem, '/')
-				if idx >= 0 {
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
 					break
 				}
-				args[0] = elem
-				elem = ""
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "DELETE":
-						r.name = SubscriptionsIDDeleteOperation
-						r.summary = "Delete subscription"
-						r.operationID = ""
-						r.pathPattern = "/subscriptions/{id}"
-						r.args = args
-						r.count = 1
-						return r, true
-					case "GET":
-						r.name = SubscriptionsIDGetOperation
-						r.summary = "Get subscription by ID"
-						r.operationID = ""
-						r.pathPattern = "/subscriptions/{id}"
-						r.args = args
-						r.count = 1
-						return r, true
-					case "PATCH":
-						r.name = SubscriptionsIDPatchOperation
-						r.summary = "Partially update subscription"
-						r.operationID = ""
-						r.pathPattern = "/subscriptions/{id}"
-						r.args = args
-						r.count = 1
-						return r, true
-					case "PUT":
-						r.name = SubscriptionsIDPutOperation
-						r.summary = "Update subscription"
-						r.operationID = ""
-						r.pathPattern = "/subscriptions/{id}"
-						r.args = args
-						r.count = 1
-						return r, true
-					default:
-						return
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "ervices"
+
+					if l := len("ervices"); len(elem) >= l && elem[0:l] == "ervices" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ServicesGetOperation
+							r.summary = "List the service catalog"
+							r.operationID = ""
+							r.pathPattern = "/services"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = ServicesPostOperation
+							r.summary = "Add a service to the catalog"
+							r.operationID = ""
+							r.pathPattern = "/services"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = ServicesIDDeleteOperation
+								r.summary = "Delete service"
+								r.operationID = ""
+								r.pathPattern = "/services/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = ServicesIDGetOperation
+								r.summary = "Get service by ID"
+								r.operationID = ""
+								r.pathPattern = "/services/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = ServicesIDPatchOperation
+								r.summary = "Update service"
+								r.operationID = ""
+								r.pathPattern = "/services/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'u': // Prefix: "ubscriptions"
+
+					if l := len("ubscriptions"); len(elem) >= l && elem[0:l] == "ubscriptions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = SubscriptionsGetOperation
+							r.summary = "List server with filtering"
+							r.operationID = ""
+							r.pathPattern = "/subscriptions"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = SubscriptionsPostOperation
+							r.summary = "Create a new subscription"
+							r.operationID = ""
+							r.pathPattern = "/subscriptions"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "summary/total-cost"
+							origElem := elem
+							if l := len("summary/total-cost"); len(elem) >= l && elem[0:l] == "summary/total-cost" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = SubscriptionsSummaryTotalCostGetOperation
+									r.summary = "Get total subscription cost"
+									r.operationID = ""
+									r.pathPattern = "/subscriptions/summary/total-cost"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 't': // Prefix: "trials/ending"
+							origElem := elem
+							if l := len("trials/ending"); len(elem) >= l && elem[0:l] == "trials/ending" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = SubscriptionsTrialsEndingGetOperation
+									r.summary = "List trials ending soon"
+									r.operationID = ""
+									r.pathPattern = "/subscriptions/trials/ending"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = SubscriptionsIDDeleteOperation
+								r.summary = "Delete subscription"
+								r.operationID = ""
+								r.pathPattern = "/subscriptions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = SubscriptionsIDGetOperation
+								r.summary = "Get subscription by ID"
+								r.operationID = ""
+								r.pathPattern = "/subscriptions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = SubscriptionsIDPatchOperation
+								r.summary = "Partially update subscription"
+								r.operationID = ""
+								r.pathPattern = "/subscriptions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = SubscriptionsIDPutOperation
+								r.summary = "Update subscription"
+								r.operationID = ""
+								r.pathPattern = "/subscriptions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SubscriptionsIDCancelPostOperation
+										r.summary = "Cancel subscription"
+										r.operationID = ""
+										r.pathPattern = "/subscriptions/{id}/cancel"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "pause"
+
+								if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SubscriptionsIDPausePostOperation
+										r.summary = "Pause subscription"
+										r.operationID = ""
+										r.pathPattern = "/subscriptions/{id}/pause"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "resume"
+
+								if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SubscriptionsIDResumePostOperation
+										r.summary = "Resume subscription"
+										r.operationID = ""
+										r.pathPattern = "/subscriptions/{id}/resume"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
 				}
 
 			}
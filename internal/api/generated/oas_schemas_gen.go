@@ -6,142 +6,528 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-faster/jx"
+	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
 
-func (s *ErrorStatusCode) Error() string {
+func (s *ProblemStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	Error     string          `json:"error"`
-	Message   string          `json:"message"`
-	Details   OptErrorDetails `json:"details"`
-	Code      OptInt32        `json:"code"`
-	Timestamp OptDateTime     `json:"timestamp"`
+// Ref: #/components/schemas/APIKey
+type APIKey struct {
+	ID         OptUUID        `json:"id"`
+	Name       OptString      `json:"name"`
+	Prefix     OptString      `json:"prefix"`
+	Scopes     []APIKeyScope  `json:"scopes"`
+	CreatedAt  OptDateTime    `json:"created_at"`
+	ExpiresAt  OptNilDateTime `json:"expires_at"`
+	LastUsedAt OptNilDateTime `json:"last_used_at"`
+	RevokedAt  OptNilDateTime `json:"revoked_at"`
 }
 
-// GetError returns the value of Error.
-func (s *Error) GetError() string {
-	return s.Error
+// GetID returns the value of ID.
+func (s *APIKey) GetID() OptUUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *APIKey) GetName() OptString {
+	return s.Name
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *APIKey) GetPrefix() OptString {
+	return s.Prefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *APIKey) GetScopes() []APIKeyScope {
+	return s.Scopes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIKey) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKey) GetExpiresAt() OptNilDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIKey) GetLastUsedAt() OptNilDateTime {
+	return s.LastUsedAt
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *APIKey) GetRevokedAt() OptNilDateTime {
+	return s.RevokedAt
+}
+
+// SetID sets the value of ID.
+func (s *APIKey) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *APIKey) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *APIKey) SetPrefix(val OptString) {
+	s.Prefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *APIKey) SetScopes(val []APIKeyScope) {
+	s.Scopes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIKey) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKey) SetExpiresAt(val OptNilDateTime) {
+	s.ExpiresAt = val
 }
 
-// GetDetails returns the value of Details.
-func (s *Error) GetDetails() OptErrorDetails {
-	return s.Details
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIKey) SetLastUsedAt(val OptNilDateTime) {
+	s.LastUsedAt = val
 }
 
-// GetCode returns the value of Code.
-func (s *Error) GetCode() OptInt32 {
-	return s.Code
+// SetRevokedAt sets the value of RevokedAt.
+func (s *APIKey) SetRevokedAt(val OptNilDateTime) {
+	s.RevokedAt = val
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *Error) GetTimestamp() OptDateTime {
-	return s.Timestamp
+func (*APIKey) adminAPIKeysIDGetRes()   {}
+func (*APIKey) adminAPIKeysIDPatchRes() {}
+
+// Ref: #/components/schemas/APIKeyCreate
+type APIKeyCreate struct {
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	ExpiresAt OptDateTime   `json:"expires_at"`
+}
+
+// GetName returns the value of Name.
+func (s *APIKeyCreate) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *APIKeyCreate) GetScopes() []APIKeyScope {
+	return s.Scopes
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKeyCreate) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetName sets the value of Name.
+func (s *APIKeyCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *APIKeyCreate) SetScopes(val []APIKeyScope) {
+	s.Scopes = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKeyCreate) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/APIKeyCreated
+type APIKeyCreated struct {
+	APIKey APIKey `json:"api_key"`
+	// Plaintext API key, shown only once.
+	Key string `json:"key"`
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *APIKeyCreated) GetAPIKey() APIKey {
+	return s.APIKey
+}
+
+// GetKey returns the value of Key.
+func (s *APIKeyCreated) GetKey() string {
+	return s.Key
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *APIKeyCreated) SetAPIKey(val APIKey) {
+	s.APIKey = val
+}
+
+// SetKey sets the value of Key.
+func (s *APIKeyCreated) SetKey(val string) {
+	s.Key = val
 }
 
-// SetError sets the value of Error.
-func (s *Error) SetError(val string) {
-	s.Error = val
+func (*APIKeyCreated) adminAPIKeysPostRes() {}
+
+// Ref: #/components/schemas/APIKeyPatch
+type APIKeyPatch struct {
+	Name   OptString     `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// GetName returns the value of Name.
+func (s *APIKeyPatch) GetName() OptString {
+	return s.Name
 }
 
-// SetDetails sets the value of Details.
-func (s *Error) SetDetails(val OptErrorDetails) {
-	s.Details = val
+// GetScopes returns the value of Scopes.
+func (s *APIKeyPatch) GetScopes() []APIKeyScope {
+	return s.Scopes
 }
 
-// SetCode sets the value of Code.
-func (s *Error) SetCode(val OptInt32) {
-	s.Code = val
+// SetName sets the value of Name.
+func (s *APIKeyPatch) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *Error) SetTimestamp(val OptDateTime) {
-	s.Timestamp = val
+// SetScopes sets the value of Scopes.
+func (s *APIKeyPatch) SetScopes(val []APIKeyScope) {
+	s.Scopes = val
 }
 
-type ErrorDetails map[string]jx.Raw
+// Ref: #/components/schemas/APIKeyScope
+type APIKeyScope string
 
-func (s *ErrorDetails) init() ErrorDetails {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
+const (
+	APIKeyScopeRead      APIKeyScope = "read"
+	APIKeyScopeWrite     APIKeyScope = "write"
+	APIKeyScopeAnalytics APIKeyScope = "analytics"
+	APIKeyScopeAdmin     APIKeyScope = "admin"
+)
+
+// AllValues returns all APIKeyScope values.
+func (APIKeyScope) AllValues() []APIKeyScope {
+	return []APIKeyScope{
+		APIKeyScopeRead,
+		APIKeyScopeWrite,
+		APIKeyScopeAnalytics,
+		APIKeyScopeAdmin,
 	}
-	return m
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// MarshalText implements encoding.TextMarshaler.
+func (s APIKeyScope) MarshalText() ([]byte, error) {
+	switch s {
+	case APIKeyScopeRead:
+		return []byte(s), nil
+	case APIKeyScopeWrite:
+		return []byte(s), nil
+	case APIKeyScopeAnalytics:
+		return []byte(s), nil
+	case APIKeyScopeAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIKeyScope) UnmarshalText(data []byte) error {
+	switch APIKeyScope(data) {
+	case APIKeyScopeRead:
+		*s = APIKeyScopeRead
+		return nil
+	case APIKeyScopeWrite:
+		*s = APIKeyScopeWrite
+		return nil
+	case APIKeyScopeAnalytics:
+		*s = APIKeyScopeAnalytics
+		return nil
+	case APIKeyScopeAdmin:
+		*s = APIKeyScopeAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+type AdminAPIKeysGetOK struct {
+	Data []APIKey `json:"data"`
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// GetData returns the value of Data.
+func (s *AdminAPIKeysGetOK) GetData() []APIKey {
+	return s.Data
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// SetData sets the value of Data.
+func (s *AdminAPIKeysGetOK) SetData(val []APIKey) {
+	s.Data = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// AdminAPIKeysIDDeleteNoContent is response for AdminAPIKeysIDDelete operation.
+type AdminAPIKeysIDDeleteNoContent struct{}
+
+func (*AdminAPIKeysIDDeleteNoContent) adminAPIKeysIDDeleteRes() {}
+
+type AdminAPIKeysIDPatchBadRequest Problem
+
+func (*AdminAPIKeysIDPatchBadRequest) adminAPIKeysIDPatchRes() {}
+
+type AdminAPIKeysIDPatchNotFound Problem
+
+func (*AdminAPIKeysIDPatchNotFound) adminAPIKeysIDPatchRes() {}
+
+type ApiKeyAuth struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *ApiKeyAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *ApiKeyAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *ApiKeyAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *ApiKeyAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// How often the service charges, subscription prices are monthly.
+// Ref: #/components/schemas/BillingCycle
+type BillingCycle string
+
+const (
+	BillingCycleMonthly BillingCycle = "monthly"
+	BillingCycleYearly  BillingCycle = "yearly"
+)
+
+// AllValues returns all BillingCycle values.
+func (BillingCycle) AllValues() []BillingCycle {
+	return []BillingCycle{
+		BillingCycleMonthly,
+		BillingCycleYearly,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BillingCycle) MarshalText() ([]byte, error) {
+	switch s {
+	case BillingCycleMonthly:
+		return []byte(s), nil
+	case BillingCycleYearly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BillingCycle) UnmarshalText(data []byte) error {
+	switch BillingCycle(data) {
+	case BillingCycleMonthly:
+		*s = BillingCycleMonthly
+		return nil
+	case BillingCycleYearly:
+		*s = BillingCycleYearly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CostBreakdownItem
+type CostBreakdownItem struct {
+	// Category or tag, empty for subscriptions without one.
+	Key string `json:"key"`
+	// Charged cost after discounts including tax in minor units of the currency of the total.
+	TotalCost int64 `json:"total_cost"`
+	// Charged cost after discounts without tax in minor units of the currency of the total.
+	NetCost int64 `json:"net_cost"`
+	// Tax of the cost in minor units of the currency of the total.
+	Tax int64 `json:"tax"`
+	// Amount the discounts took off the cost in minor units of the currency of the total.
+	Discount int64 `json:"discount"`
+}
+
+// GetKey returns the value of Key.
+func (s *CostBreakdownItem) GetKey() string {
+	return s.Key
+}
+
+// GetTotalCost returns the value of TotalCost.
+func (s *CostBreakdownItem) GetTotalCost() int64 {
+	return s.TotalCost
+}
+
+// GetNetCost returns the value of NetCost.
+func (s *CostBreakdownItem) GetNetCost() int64 {
+	return s.NetCost
+}
+
+// GetTax returns the value of Tax.
+func (s *CostBreakdownItem) GetTax() int64 {
+	return s.Tax
+}
+
+// GetDiscount returns the value of Discount.
+func (s *CostBreakdownItem) GetDiscount() int64 {
+	return s.Discount
+}
+
+// SetKey sets the value of Key.
+func (s *CostBreakdownItem) SetKey(val string) {
+	s.Key = val
+}
+
+// SetTotalCost sets the value of TotalCost.
+func (s *CostBreakdownItem) SetTotalCost(val int64) {
+	s.TotalCost = val
+}
+
+// SetNetCost sets the value of NetCost.
+func (s *CostBreakdownItem) SetNetCost(val int64) {
+	s.NetCost = val
+}
+
+// SetTax sets the value of Tax.
+func (s *CostBreakdownItem) SetTax(val int64) {
+	s.Tax = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *CostBreakdownItem) SetDiscount(val int64) {
+	s.Discount = val
+}
+
+// Ref: #/components/schemas/FieldError
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// GetField returns the value of Field.
+func (s *FieldError) GetField() string {
+	return s.Field
+}
+
+// GetReason returns the value of Reason.
+func (s *FieldError) GetReason() string {
+	return s.Reason
+}
+
+// SetField sets the value of Field.
+func (s *FieldError) SetField(val string) {
+	s.Field = val
+}
+
+// SetReason sets the value of Reason.
+func (s *FieldError) SetReason(val string) {
+	s.Reason = val
+}
+
+// An exact amount, e.g. 399.00 rubles are 39900 RUB.
+// Ref: #/components/schemas/Money
+type Money struct {
+	// Amount in minor units of the currency, e.g. kopecks or cents.
+	Amount int64 `json:"amount"`
+	// ISO 4217 currency code.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *Money) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Money) GetCurrency() string {
+	return s.Currency
+}
+
+// SetAmount sets the value of Amount.
+func (s *Money) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Money) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// NewOptBillingCycle returns new OptBillingCycle with value set to v.
+func NewOptBillingCycle(v BillingCycle) OptBillingCycle {
+	return OptBillingCycle{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptBillingCycle is optional BillingCycle.
+type OptBillingCycle struct {
+	Value BillingCycle
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptBillingCycle was set.
+func (o OptBillingCycle) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptBillingCycle) Reset() {
+	var v BillingCycle
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptBillingCycle) SetTo(v BillingCycle) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptBillingCycle) Get() (v BillingCycle, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -149,45 +535,45 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptBillingCycle) Or(d BillingCycle) BillingCycle {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptErrorDetails returns new OptErrorDetails with value set to v.
-func NewOptErrorDetails(v ErrorDetails) OptErrorDetails {
-	return OptErrorDetails{
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptErrorDetails is optional ErrorDetails.
-type OptErrorDetails struct {
-	Value ErrorDetails
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
 	Set   bool
 }
 
-// IsSet returns true if OptErrorDetails was set.
-func (o OptErrorDetails) IsSet() bool { return o.Set }
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptErrorDetails) Reset() {
-	var v ErrorDetails
+func (o *OptBool) Reset() {
+	var v bool
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptErrorDetails) SetTo(v ErrorDetails) {
+func (o *OptBool) SetTo(v bool) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptErrorDetails) Get() (v ErrorDetails, ok bool) {
+func (o OptBool) Get() (v bool, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -195,45 +581,45 @@ func (o OptErrorDetails) Get() (v ErrorDetails, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptErrorDetails) Or(d ErrorDetails) ErrorDetails {
+func (o OptBool) Or(d bool) bool {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptDateTime) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptDateTime) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -241,45 +627,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt32 returns new OptInt32 with value set to v.
-func NewOptInt32(v int32) OptInt32 {
-	return OptInt32{
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt32 is optional int32.
-type OptInt32 struct {
-	Value int32
+// OptInt is optional int.
+type OptInt struct {
+	Value int
 	Set   bool
 }
 
-// IsSet returns true if OptInt32 was set.
-func (o OptInt32) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt32) Reset() {
-	var v int32
+func (o *OptInt) Reset() {
+	var v int
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt32) SetTo(v int32) {
+func (o *OptInt) SetTo(v int) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt32) Get() (v int32, ok bool) {
+func (o OptInt) Get() (v int, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -287,62 +673,45 @@ func (o OptInt32) Get() (v int32, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt32) Or(d int32) int32 {
+func (o OptInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
+func (o *OptInt64) Reset() {
+	var v int64
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
+func (o *OptInt64) SetTo(v int64) {
 	o.Set = true
-	o.Null = true
-	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptInt64) Get() (v int64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -350,45 +719,45 @@ func (o OptNilString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
+func (o OptInt64) Or(d int64) int64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptPagination returns new OptPagination with value set to v.
-func NewOptPagination(v Pagination) OptPagination {
-	return OptPagination{
+// NewOptMoney returns new OptMoney with value set to v.
+func NewOptMoney(v Money) OptMoney {
+	return OptMoney{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPagination is optional Pagination.
-type OptPagination struct {
-	Value Pagination
+// OptMoney is optional Money.
+type OptMoney struct {
+	Value Money
 	Set   bool
 }
 
-// IsSet returns true if OptPagination was set.
-func (o OptPagination) IsSet() bool { return o.Set }
+// IsSet returns true if OptMoney was set.
+func (o OptMoney) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPagination) Reset() {
-	var v Pagination
+func (o *OptMoney) Reset() {
+	var v Money
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPagination) SetTo(v Pagination) {
+func (o *OptMoney) SetTo(v Money) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPagination) Get() (v Pagination, ok bool) {
+func (o OptMoney) Get() (v Money, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -396,45 +765,62 @@ func (o OptPagination) Get() (v Pagination, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPagination) Or(d Pagination) Pagination {
+func (o OptMoney) Or(d Money) Money {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
+	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
 	o.Set = true
+	o.Null = true
+	var v time.Time
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
 	if !o.Set {
 		return v, false
 	}
@@ -442,45 +828,62 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptNilDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptSubscriptionsSummaryTotalCostGetOKFilterCriteria returns new OptSubscriptionsSummaryTotalCostGetOKFilterCriteria with value set to v.
-func NewOptSubscriptionsSummaryTotalCostGetOKFilterCriteria(v SubscriptionsSummaryTotalCostGetOKFilterCriteria) OptSubscriptionsSummaryTotalCostGetOKFilterCriteria {
-	return OptSubscriptionsSummaryTotalCostGetOKFilterCriteria{
+// NewOptNilMoney returns new OptNilMoney with value set to v.
+func NewOptNilMoney(v Money) OptNilMoney {
+	return OptNilMoney{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptSubscriptionsSummaryTotalCostGetOKFilterCriteria is optional SubscriptionsSummaryTotalCostGetOKFilterCriteria.
-type OptSubscriptionsSummaryTotalCostGetOKFilterCriteria struct {
-	Value SubscriptionsSummaryTotalCostGetOKFilterCriteria
+// OptNilMoney is optional nullable Money.
+type OptNilMoney struct {
+	Value Money
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptSubscriptionsSummaryTotalCostGetOKFilterCriteria was set.
-func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilMoney was set.
+func (o OptNilMoney) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Reset() {
-	var v SubscriptionsSummaryTotalCostGetOKFilterCriteria
+func (o *OptNilMoney) Reset() {
+	var v Money
 	o.Value = v
 	o.Set = false
+	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) SetTo(v SubscriptionsSummaryTotalCostGetOKFilterCriteria) {
+func (o *OptNilMoney) SetTo(v Money) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilMoney) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilMoney) SetToNull() {
 	o.Set = true
+	o.Null = true
+	var v Money
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Get() (v SubscriptionsSummaryTotalCostGetOKFilterCriteria, ok bool) {
+func (o OptNilMoney) Get() (v Money, ok bool) {
+	if o.Null {
+		return v, false
+	}
 	if !o.Set {
 		return v, false
 	}
@@ -488,45 +891,62 @@ func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Get() (v Subscripti
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Or(d SubscriptionsSummaryTotalCostGetOKFilterCriteria) SubscriptionsSummaryTotalCostGetOKFilterCriteria {
+func (o OptNilMoney) Or(d Money) Money {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptSubscriptionsSummaryTotalCostGetOKPeriod returns new OptSubscriptionsSummaryTotalCostGetOKPeriod with value set to v.
-func NewOptSubscriptionsSummaryTotalCostGetOKPeriod(v SubscriptionsSummaryTotalCostGetOKPeriod) OptSubscriptionsSummaryTotalCostGetOKPeriod {
-	return OptSubscriptionsSummaryTotalCostGetOKPeriod{
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptSubscriptionsSummaryTotalCostGetOKPeriod is optional SubscriptionsSummaryTotalCostGetOKPeriod.
-type OptSubscriptionsSummaryTotalCostGetOKPeriod struct {
-	Value SubscriptionsSummaryTotalCostGetOKPeriod
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptSubscriptionsSummaryTotalCostGetOKPeriod was set.
-func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptSubscriptionsSummaryTotalCostGetOKPeriod) Reset() {
-	var v SubscriptionsSummaryTotalCostGetOKPeriod
+func (o *OptNilString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
+	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptSubscriptionsSummaryTotalCostGetOKPeriod) SetTo(v SubscriptionsSummaryTotalCostGetOKPeriod) {
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
 	o.Set = true
+	o.Null = true
+	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) Get() (v SubscriptionsSummaryTotalCostGetOKPeriod, ok bool) {
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
 	if !o.Set {
 		return v, false
 	}
@@ -534,45 +954,62 @@ func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) Get() (v SubscriptionsSumma
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) Or(d SubscriptionsSummaryTotalCostGetOKPeriod) SubscriptionsSummaryTotalCostGetOKPeriod {
+func (o OptNilString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
 	Value uuid.UUID
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUUID) Reset() {
+func (o *OptNilUUID) Reset() {
 	var v uuid.UUID
 	o.Value = v
 	o.Set = false
+	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
 	o.Set = true
+	o.Null = true
+	var v uuid.UUID
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
 	if !o.Set {
 		return v, false
 	}
@@ -580,263 +1017,1794 @@ func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #/components/schemas/Pagination
-type Pagination struct {
-	Page  OptInt `json:"page"`
-	Limit OptInt `json:"limit"`
-	Total OptInt `json:"total"`
-	Pages OptInt `json:"pages"`
+// NewOptPagination returns new OptPagination with value set to v.
+func NewOptPagination(v Pagination) OptPagination {
+	return OptPagination{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetPage returns the value of Page.
-func (s *Pagination) GetPage() OptInt {
-	return s.Page
+// OptPagination is optional Pagination.
+type OptPagination struct {
+	Value Pagination
+	Set   bool
 }
 
-// GetLimit returns the value of Limit.
-func (s *Pagination) GetLimit() OptInt {
-	return s.Limit
+// IsSet returns true if OptPagination was set.
+func (o OptPagination) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPagination) Reset() {
+	var v Pagination
+	o.Value = v
+	o.Set = false
 }
 
-// GetTotal returns the value of Total.
-func (s *Pagination) GetTotal() OptInt {
-	return s.Total
+// SetTo sets value to v.
+func (o *OptPagination) SetTo(v Pagination) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetPages returns the value of Pages.
-func (s *Pagination) GetPages() OptInt {
-	return s.Pages
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPagination) Get() (v Pagination, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetPage sets the value of Page.
-func (s *Pagination) SetPage(val OptInt) {
-	s.Page = val
+// Or returns value if set, or given parameter if does not.
+func (o OptPagination) Or(d Pagination) Pagination {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetLimit sets the value of Limit.
-func (s *Pagination) SetLimit(val OptInt) {
-	s.Limit = val
+// NewOptSplitRule returns new OptSplitRule with value set to v.
+func NewOptSplitRule(v SplitRule) OptSplitRule {
+	return OptSplitRule{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetTotal sets the value of Total.
-func (s *Pagination) SetTotal(val OptInt) {
-	s.Total = val
+// OptSplitRule is optional SplitRule.
+type OptSplitRule struct {
+	Value SplitRule
+	Set   bool
 }
 
-// SetPages sets the value of Pages.
-func (s *Pagination) SetPages(val OptInt) {
-	s.Pages = val
+// IsSet returns true if OptSplitRule was set.
+func (o OptSplitRule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSplitRule) Reset() {
+	var v SplitRule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSplitRule) SetTo(v SplitRule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSplitRule) Get() (v SplitRule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSplitRule) Or(d SplitRule) SplitRule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubscriptionStatus returns new OptSubscriptionStatus with value set to v.
+func NewOptSubscriptionStatus(v SubscriptionStatus) OptSubscriptionStatus {
+	return OptSubscriptionStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubscriptionStatus is optional SubscriptionStatus.
+type OptSubscriptionStatus struct {
+	Value SubscriptionStatus
+	Set   bool
+}
+
+// IsSet returns true if OptSubscriptionStatus was set.
+func (o OptSubscriptionStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubscriptionStatus) Reset() {
+	var v SubscriptionStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubscriptionStatus) SetTo(v SubscriptionStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubscriptionStatus) Get() (v SubscriptionStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubscriptionStatus) Or(d SubscriptionStatus) SubscriptionStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubscriptionsSummaryTotalCostGetAttribution returns new OptSubscriptionsSummaryTotalCostGetAttribution with value set to v.
+func NewOptSubscriptionsSummaryTotalCostGetAttribution(v SubscriptionsSummaryTotalCostGetAttribution) OptSubscriptionsSummaryTotalCostGetAttribution {
+	return OptSubscriptionsSummaryTotalCostGetAttribution{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubscriptionsSummaryTotalCostGetAttribution is optional SubscriptionsSummaryTotalCostGetAttribution.
+type OptSubscriptionsSummaryTotalCostGetAttribution struct {
+	Value SubscriptionsSummaryTotalCostGetAttribution
+	Set   bool
+}
+
+// IsSet returns true if OptSubscriptionsSummaryTotalCostGetAttribution was set.
+func (o OptSubscriptionsSummaryTotalCostGetAttribution) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubscriptionsSummaryTotalCostGetAttribution) Reset() {
+	var v SubscriptionsSummaryTotalCostGetAttribution
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubscriptionsSummaryTotalCostGetAttribution) SetTo(v SubscriptionsSummaryTotalCostGetAttribution) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubscriptionsSummaryTotalCostGetAttribution) Get() (v SubscriptionsSummaryTotalCostGetAttribution, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubscriptionsSummaryTotalCostGetAttribution) Or(d SubscriptionsSummaryTotalCostGetAttribution) SubscriptionsSummaryTotalCostGetAttribution {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubscriptionsSummaryTotalCostGetGroupBy returns new OptSubscriptionsSummaryTotalCostGetGroupBy with value set to v.
+func NewOptSubscriptionsSummaryTotalCostGetGroupBy(v SubscriptionsSummaryTotalCostGetGroupBy) OptSubscriptionsSummaryTotalCostGetGroupBy {
+	return OptSubscriptionsSummaryTotalCostGetGroupBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubscriptionsSummaryTotalCostGetGroupBy is optional SubscriptionsSummaryTotalCostGetGroupBy.
+type OptSubscriptionsSummaryTotalCostGetGroupBy struct {
+	Value SubscriptionsSummaryTotalCostGetGroupBy
+	Set   bool
+}
+
+// IsSet returns true if OptSubscriptionsSummaryTotalCostGetGroupBy was set.
+func (o OptSubscriptionsSummaryTotalCostGetGroupBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubscriptionsSummaryTotalCostGetGroupBy) Reset() {
+	var v SubscriptionsSummaryTotalCostGetGroupBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubscriptionsSummaryTotalCostGetGroupBy) SetTo(v SubscriptionsSummaryTotalCostGetGroupBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubscriptionsSummaryTotalCostGetGroupBy) Get() (v SubscriptionsSummaryTotalCostGetGroupBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubscriptionsSummaryTotalCostGetGroupBy) Or(d SubscriptionsSummaryTotalCostGetGroupBy) SubscriptionsSummaryTotalCostGetGroupBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubscriptionsSummaryTotalCostGetOKFilterCriteria returns new OptSubscriptionsSummaryTotalCostGetOKFilterCriteria with value set to v.
+func NewOptSubscriptionsSummaryTotalCostGetOKFilterCriteria(v SubscriptionsSummaryTotalCostGetOKFilterCriteria) OptSubscriptionsSummaryTotalCostGetOKFilterCriteria {
+	return OptSubscriptionsSummaryTotalCostGetOKFilterCriteria{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubscriptionsSummaryTotalCostGetOKFilterCriteria is optional SubscriptionsSummaryTotalCostGetOKFilterCriteria.
+type OptSubscriptionsSummaryTotalCostGetOKFilterCriteria struct {
+	Value SubscriptionsSummaryTotalCostGetOKFilterCriteria
+	Set   bool
+}
+
+// IsSet returns true if OptSubscriptionsSummaryTotalCostGetOKFilterCriteria was set.
+func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Reset() {
+	var v SubscriptionsSummaryTotalCostGetOKFilterCriteria
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) SetTo(v SubscriptionsSummaryTotalCostGetOKFilterCriteria) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Get() (v SubscriptionsSummaryTotalCostGetOKFilterCriteria, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubscriptionsSummaryTotalCostGetOKFilterCriteria) Or(d SubscriptionsSummaryTotalCostGetOKFilterCriteria) SubscriptionsSummaryTotalCostGetOKFilterCriteria {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubscriptionsSummaryTotalCostGetOKPeriod returns new OptSubscriptionsSummaryTotalCostGetOKPeriod with value set to v.
+func NewOptSubscriptionsSummaryTotalCostGetOKPeriod(v SubscriptionsSummaryTotalCostGetOKPeriod) OptSubscriptionsSummaryTotalCostGetOKPeriod {
+	return OptSubscriptionsSummaryTotalCostGetOKPeriod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubscriptionsSummaryTotalCostGetOKPeriod is optional SubscriptionsSummaryTotalCostGetOKPeriod.
+type OptSubscriptionsSummaryTotalCostGetOKPeriod struct {
+	Value SubscriptionsSummaryTotalCostGetOKPeriod
+	Set   bool
+}
+
+// IsSet returns true if OptSubscriptionsSummaryTotalCostGetOKPeriod was set.
+func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubscriptionsSummaryTotalCostGetOKPeriod) Reset() {
+	var v SubscriptionsSummaryTotalCostGetOKPeriod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubscriptionsSummaryTotalCostGetOKPeriod) SetTo(v SubscriptionsSummaryTotalCostGetOKPeriod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) Get() (v SubscriptionsSummaryTotalCostGetOKPeriod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubscriptionsSummaryTotalCostGetOKPeriod) Or(d SubscriptionsSummaryTotalCostGetOKPeriod) SubscriptionsSummaryTotalCostGetOKPeriod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Pagination
+type Pagination struct {
+	Page  OptInt `json:"page"`
+	Limit OptInt `json:"limit"`
+	Total OptInt `json:"total"`
+	Pages OptInt `json:"pages"`
+}
+
+// GetPage returns the value of Page.
+func (s *Pagination) GetPage() OptInt {
+	return s.Page
+}
+
+// GetLimit returns the value of Limit.
+func (s *Pagination) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetTotal returns the value of Total.
+func (s *Pagination) GetTotal() OptInt {
+	return s.Total
+}
+
+// GetPages returns the value of Pages.
+func (s *Pagination) GetPages() OptInt {
+	return s.Pages
+}
+
+// SetPage sets the value of Page.
+func (s *Pagination) SetPage(val OptInt) {
+	s.Page = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *Pagination) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetTotal sets the value of Total.
+func (s *Pagination) SetTotal(val OptInt) {
+	s.Total = val
+}
+
+// SetPages sets the value of Pages.
+func (s *Pagination) SetPages(val OptInt) {
+	s.Pages = val
+}
+
+// Problem details of a failed request (RFC 7807).
+// Ref: #/components/schemas/Problem
+type Problem struct {
+	// URI identifying the kind of problem.
+	Type string `json:"type"`
+	// Short summary of the kind of problem.
+	Title  string `json:"title"`
+	Status int32  `json:"status"`
+	// Explanation specific to this occurrence of the problem.
+	Detail OptString `json:"detail"`
+	// Path of the request the problem occurred in.
+	Instance OptString `json:"instance"`
+	// ID of the request, also sent in the X-Request-ID header.
+	RequestID OptString `json:"request_id"`
+	// Every invalid field of the request.
+	Errors []FieldError `json:"errors"`
+}
+
+// GetType returns the value of Type.
+func (s *Problem) GetType() string {
+	return s.Type
+}
+
+// GetTitle returns the value of Title.
+func (s *Problem) GetTitle() string {
+	return s.Title
+}
+
+// GetStatus returns the value of Status.
+func (s *Problem) GetStatus() int32 {
+	return s.Status
+}
+
+// GetDetail returns the value of Detail.
+func (s *Problem) GetDetail() OptString {
+	return s.Detail
+}
+
+// GetInstance returns the value of Instance.
+func (s *Problem) GetInstance() OptString {
+	return s.Instance
+}
+
+// GetRequestID returns the value of RequestID.
+func (s *Problem) GetRequestID() OptString {
+	return s.RequestID
+}
+
+// GetErrors returns the value of Errors.
+func (s *Problem) GetErrors() []FieldError {
+	return s.Errors
+}
+
+// SetType sets the value of Type.
+func (s *Problem) SetType(val string) {
+	s.Type = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Problem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Problem) SetStatus(val int32) {
+	s.Status = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *Problem) SetDetail(val OptString) {
+	s.Detail = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *Problem) SetInstance(val OptString) {
+	s.Instance = val
+}
+
+// SetRequestID sets the value of RequestID.
+func (s *Problem) SetRequestID(val OptString) {
+	s.RequestID = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *Problem) SetErrors(val []FieldError) {
+	s.Errors = val
+}
+
+func (*Problem) adminAPIKeysIDDeleteRes()         {}
+func (*Problem) adminAPIKeysIDGetRes()            {}
+func (*Problem) adminAPIKeysPostRes()             {}
+func (*Problem) servicesIDGetRes()                {}
+func (*Problem) subscriptionsTrialsEndingGetRes() {}
+
+// ProblemStatusCode wraps Problem with StatusCode.
+type ProblemStatusCode struct {
+	StatusCode int
+	Response   Problem
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ProblemStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ProblemStatusCode) GetResponse() Problem {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ProblemStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ProblemStatusCode) SetResponse(val Problem) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/Service
+type Service struct {
+	ID           OptUUID         `json:"id"`
+	Name         OptString       `json:"name"`
+	Aliases      []string        `json:"aliases"`
+	Category     OptString       `json:"category"`
+	DefaultPrice OptNilMoney     `json:"default_price"`
+	BillingCycle OptBillingCycle `json:"billing_cycle"`
+	CreatedAt    OptDateTime     `json:"created_at"`
+	UpdatedAt    OptDateTime     `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Service) GetID() OptUUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Service) GetName() OptString {
+	return s.Name
+}
+
+// GetAliases returns the value of Aliases.
+func (s *Service) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetCategory returns the value of Category.
+func (s *Service) GetCategory() OptString {
+	return s.Category
+}
+
+// GetDefaultPrice returns the value of DefaultPrice.
+func (s *Service) GetDefaultPrice() OptNilMoney {
+	return s.DefaultPrice
+}
+
+// GetBillingCycle returns the value of BillingCycle.
+func (s *Service) GetBillingCycle() OptBillingCycle {
+	return s.BillingCycle
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Service) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Service) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Service) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Service) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *Service) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetCategory sets the value of Category.
+func (s *Service) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetDefaultPrice sets the value of DefaultPrice.
+func (s *Service) SetDefaultPrice(val OptNilMoney) {
+	s.DefaultPrice = val
+}
+
+// SetBillingCycle sets the value of BillingCycle.
+func (s *Service) SetBillingCycle(val OptBillingCycle) {
+	s.BillingCycle = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Service) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Service) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*Service) servicesIDGetRes()   {}
+func (*Service) servicesIDPatchRes() {}
+func (*Service) servicesPostRes()    {}
+
+// Ref: #/components/schemas/ServiceCreate
+type ServiceCreate struct {
+	Name     string    `json:"name"`
+	Aliases  []string  `json:"aliases"`
+	Category OptString `json:"category"`
+	// Monthly price of subscriptions created without one.
+	DefaultPrice OptMoney        `json:"default_price"`
+	BillingCycle OptBillingCycle `json:"billing_cycle"`
+}
+
+// GetName returns the value of Name.
+func (s *ServiceCreate) GetName() string {
+	return s.Name
+}
+
+// GetAliases returns the value of Aliases.
+func (s *ServiceCreate) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetCategory returns the value of Category.
+func (s *ServiceCreate) GetCategory() OptString {
+	return s.Category
+}
+
+// GetDefaultPrice returns the value of DefaultPrice.
+func (s *ServiceCreate) GetDefaultPrice() OptMoney {
+	return s.DefaultPrice
+}
+
+// GetBillingCycle returns the value of BillingCycle.
+func (s *ServiceCreate) GetBillingCycle() OptBillingCycle {
+	return s.BillingCycle
+}
+
+// SetName sets the value of Name.
+func (s *ServiceCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *ServiceCreate) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ServiceCreate) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetDefaultPrice sets the value of DefaultPrice.
+func (s *ServiceCreate) SetDefaultPrice(val OptMoney) {
+	s.DefaultPrice = val
+}
+
+// SetBillingCycle sets the value of BillingCycle.
+func (s *ServiceCreate) SetBillingCycle(val OptBillingCycle) {
+	s.BillingCycle = val
+}
+
+// Ref: #/components/schemas/ServicePatch
+type ServicePatch struct {
+	Name OptString `json:"name"`
+	// Replaces all aliases.
+	Aliases  []string  `json:"aliases"`
+	Category OptString `json:"category"`
+	// Null removes the default price.
+	DefaultPrice OptNilMoney     `json:"default_price"`
+	BillingCycle OptBillingCycle `json:"billing_cycle"`
+}
+
+// GetName returns the value of Name.
+func (s *ServicePatch) GetName() OptString {
+	return s.Name
+}
+
+// GetAliases returns the value of Aliases.
+func (s *ServicePatch) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetCategory returns the value of Category.
+func (s *ServicePatch) GetCategory() OptString {
+	return s.Category
+}
+
+// GetDefaultPrice returns the value of DefaultPrice.
+func (s *ServicePatch) GetDefaultPrice() OptNilMoney {
+	return s.DefaultPrice
+}
+
+// GetBillingCycle returns the value of BillingCycle.
+func (s *ServicePatch) GetBillingCycle() OptBillingCycle {
+	return s.BillingCycle
+}
+
+// SetName sets the value of Name.
+func (s *ServicePatch) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *ServicePatch) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ServicePatch) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetDefaultPrice sets the value of DefaultPrice.
+func (s *ServicePatch) SetDefaultPrice(val OptNilMoney) {
+	s.DefaultPrice = val
+}
+
+// SetBillingCycle sets the value of BillingCycle.
+func (s *ServicePatch) SetBillingCycle(val OptBillingCycle) {
+	s.BillingCycle = val
+}
+
+type ServicesGetOK struct {
+	Data []Service `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ServicesGetOK) GetData() []Service {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ServicesGetOK) SetData(val []Service) {
+	s.Data = val
+}
+
+type ServicesIDDeleteConflict Problem
+
+func (*ServicesIDDeleteConflict) servicesIDDeleteRes() {}
+
+// ServicesIDDeleteNoContent is response for ServicesIDDelete operation.
+type ServicesIDDeleteNoContent struct{}
+
+func (*ServicesIDDeleteNoContent) servicesIDDeleteRes() {}
+
+type ServicesIDDeleteNotFound Problem
+
+func (*ServicesIDDeleteNotFound) servicesIDDeleteRes() {}
+
+type ServicesIDPatchBadRequest Problem
+
+func (*ServicesIDPatchBadRequest) servicesIDPatchRes() {}
+
+type ServicesIDPatchConflict Problem
+
+func (*ServicesIDPatchConflict) servicesIDPatchRes() {}
+
+type ServicesIDPatchNotFound Problem
+
+func (*ServicesIDPatchNotFound) servicesIDPatchRes() {}
+
+type ServicesPostBadRequest Problem
+
+func (*ServicesPostBadRequest) servicesPostRes() {}
+
+type ServicesPostConflict Problem
+
+func (*ServicesPostConflict) servicesPostRes() {}
+
+// How the price of a shared subscription is divided: equal parts, a share in percent per member
+// adding up to 100, or a fixed monthly amount per member adding up to the price.
+// Ref: #/components/schemas/SplitRule
+type SplitRule string
+
+const (
+	SplitRuleEqual      SplitRule = "equal"
+	SplitRulePercentage SplitRule = "percentage"
+	SplitRuleFixed      SplitRule = "fixed"
+)
+
+// AllValues returns all SplitRule values.
+func (SplitRule) AllValues() []SplitRule {
+	return []SplitRule{
+		SplitRuleEqual,
+		SplitRulePercentage,
+		SplitRuleFixed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SplitRule) MarshalText() ([]byte, error) {
+	switch s {
+	case SplitRuleEqual:
+		return []byte(s), nil
+	case SplitRulePercentage:
+		return []byte(s), nil
+	case SplitRuleFixed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SplitRule) UnmarshalText(data []byte) error {
+	switch SplitRule(data) {
+	case SplitRuleEqual:
+		*s = SplitRuleEqual
+		return nil
+	case SplitRulePercentage:
+		*s = SplitRulePercentage
+		return nil
+	case SplitRuleFixed:
+		*s = SplitRuleFixed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Subscription
+type Subscription struct {
+	ID          OptUUID   `json:"id"`
+	ServiceName OptString `json:"service_name"`
+	// Catalog entry of the service, null for names not in the catalog.
+	ServiceID OptNilUUID `json:"service_id"`
+	Price     OptMoney   `json:"price"`
+	// VAT rate in basis points, 2000 is 20%.
+	TaxRate OptInt `json:"tax_rate"`
+	// Whether the price includes the tax, otherwise the tax is added on top of it.
+	TaxInclusive OptBool      `json:"tax_inclusive"`
+	UserID       OptUUID      `json:"user_id"`
+	StartDate    OptString    `json:"start_date"`
+	EndDate      OptNilString `json:"end_date"`
+	// Last month of the free trial, trial months are not charged.
+	TrialEndDate OptNilString `json:"trial_end_date"`
+	// Intervals the subscription is paused in ordered by start, paused months are not charged.
+	Pauses []SubscriptionPause   `json:"pauses"`
+	Status OptSubscriptionStatus `json:"status"`
+	// When the subscription was cancelled.
+	CancelledAt        OptNilDateTime `json:"cancelled_at"`
+	CancellationReason OptNilString   `json:"cancellation_reason"`
+	Category           OptString      `json:"category"`
+	Tags               []string       `json:"tags"`
+	Split              OptSplitRule   `json:"split"`
+	// Users sharing the price in their order, empty when the subscription is not shared and the paying
+	// user bears the full price.
+	Members []SubscriptionMember `json:"members"`
+	// Promotions reducing the price ordered by start, discounted months cost less.
+	Discounts []SubscriptionDiscount `json:"discounts"`
+	CreatedAt OptDateTime            `json:"created_at"`
+	UpdatedAt OptDateTime            `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Subscription) GetID() OptUUID {
+	return s.ID
+}
+
+// GetServiceName returns the value of ServiceName.
+func (s *Subscription) GetServiceName() OptString {
+	return s.ServiceName
+}
+
+// GetServiceID returns the value of ServiceID.
+func (s *Subscription) GetServiceID() OptNilUUID {
+	return s.ServiceID
+}
+
+// GetPrice returns the value of Price.
+func (s *Subscription) GetPrice() OptMoney {
+	return s.Price
+}
+
+// GetTaxRate returns the value of TaxRate.
+func (s *Subscription) GetTaxRate() OptInt {
+	return s.TaxRate
+}
+
+// GetTaxInclusive returns the value of TaxInclusive.
+func (s *Subscription) GetTaxInclusive() OptBool {
+	return s.TaxInclusive
+}
+
+// GetUserID returns the value of UserID.
+func (s *Subscription) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *Subscription) GetStartDate() OptString {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *Subscription) GetEndDate() OptNilString {
+	return s.EndDate
+}
+
+// GetTrialEndDate returns the value of TrialEndDate.
+func (s *Subscription) GetTrialEndDate() OptNilString {
+	return s.TrialEndDate
+}
+
+// GetPauses returns the value of Pauses.
+func (s *Subscription) GetPauses() []SubscriptionPause {
+	return s.Pauses
+}
+
+// GetStatus returns the value of Status.
+func (s *Subscription) GetStatus() OptSubscriptionStatus {
+	return s.Status
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *Subscription) GetCancelledAt() OptNilDateTime {
+	return s.CancelledAt
+}
+
+// GetCancellationReason returns the value of CancellationReason.
+func (s *Subscription) GetCancellationReason() OptNilString {
+	return s.CancellationReason
+}
+
+// GetCategory returns the value of Category.
+func (s *Subscription) GetCategory() OptString {
+	return s.Category
+}
+
+// GetTags returns the value of Tags.
+func (s *Subscription) GetTags() []string {
+	return s.Tags
+}
+
+// GetSplit returns the value of Split.
+func (s *Subscription) GetSplit() OptSplitRule {
+	return s.Split
+}
+
+// GetMembers returns the value of Members.
+func (s *Subscription) GetMembers() []SubscriptionMember {
+	return s.Members
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *Subscription) GetDiscounts() []SubscriptionDiscount {
+	return s.Discounts
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Subscription) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Subscription) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Subscription) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetServiceName sets the value of ServiceName.
+func (s *Subscription) SetServiceName(val OptString) {
+	s.ServiceName = val
+}
+
+// SetServiceID sets the value of ServiceID.
+func (s *Subscription) SetServiceID(val OptNilUUID) {
+	s.ServiceID = val
+}
+
+// SetPrice sets the value of Price.
+func (s *Subscription) SetPrice(val OptMoney) {
+	s.Price = val
+}
+
+// SetTaxRate sets the value of TaxRate.
+func (s *Subscription) SetTaxRate(val OptInt) {
+	s.TaxRate = val
+}
+
+// SetTaxInclusive sets the value of TaxInclusive.
+func (s *Subscription) SetTaxInclusive(val OptBool) {
+	s.TaxInclusive = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Subscription) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *Subscription) SetStartDate(val OptString) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *Subscription) SetEndDate(val OptNilString) {
+	s.EndDate = val
+}
+
+// SetTrialEndDate sets the value of TrialEndDate.
+func (s *Subscription) SetTrialEndDate(val OptNilString) {
+	s.TrialEndDate = val
+}
+
+// SetPauses sets the value of Pauses.
+func (s *Subscription) SetPauses(val []SubscriptionPause) {
+	s.Pauses = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Subscription) SetStatus(val OptSubscriptionStatus) {
+	s.Status = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *Subscription) SetCancelledAt(val OptNilDateTime) {
+	s.CancelledAt = val
+}
+
+// SetCancellationReason sets the value of CancellationReason.
+func (s *Subscription) SetCancellationReason(val OptNilString) {
+	s.CancellationReason = val
+}
+
+// SetCategory sets the value of Category.
+func (s *Subscription) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Subscription) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetSplit sets the value of Split.
+func (s *Subscription) SetSplit(val OptSplitRule) {
+	s.Split = val
+}
+
+// SetMembers sets the value of Members.
+func (s *Subscription) SetMembers(val []SubscriptionMember) {
+	s.Members = val
+}
+
+// SetDiscounts sets the value of Discounts.
+func (s *Subscription) SetDiscounts(val []SubscriptionDiscount) {
+	s.Discounts = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Subscription) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Subscription) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*Subscription) subscriptionsIDCancelPostRes() {}
+func (*Subscription) subscriptionsIDGetRes()        {}
+func (*Subscription) subscriptionsIDPatchRes()      {}
+func (*Subscription) subscriptionsIDPausePostRes()  {}
+func (*Subscription) subscriptionsIDPutRes()        {}
+func (*Subscription) subscriptionsIDResumePostRes() {}
+func (*Subscription) subscriptionsPostRes()         {}
+
+// Ref: #/components/schemas/SubscriptionCancelRequest
+type SubscriptionCancelRequest struct {
+	// Last charged month, defaults to the current month.
+	EndDate OptString `json:"end_date"`
+	Reason  OptString `json:"reason"`
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *SubscriptionCancelRequest) GetEndDate() OptString {
+	return s.EndDate
+}
+
+// GetReason returns the value of Reason.
+func (s *SubscriptionCancelRequest) GetReason() OptString {
+	return s.Reason
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *SubscriptionCancelRequest) SetEndDate(val OptString) {
+	s.EndDate = val
+}
+
+// SetReason sets the value of Reason.
+func (s *SubscriptionCancelRequest) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Ref: #/components/schemas/SubscriptionCreate
+type SubscriptionCreate struct {
+	ServiceName string `json:"service_name"`
+	// Monthly price, defaults to the default price of the catalog entry of the service.
+	Price OptMoney `json:"price"`
+	// VAT rate in basis points, 2000 is 20%.
+	TaxRate OptInt `json:"tax_rate"`
+	// Whether the price includes the tax, otherwise the tax is added on top of it.
+	TaxInclusive OptBool   `json:"tax_inclusive"`
+	UserID       uuid.UUID `json:"user_id"`
+	// Date in MM-YYYY format.
+	StartDate string `json:"start_date"`
+	// Optional end date in MM-YYYY format.
+	EndDate OptNilString `json:"end_date"`
+	// Optional last month of the free trial in MM-YYYY format, trial months are not charged.
+	TrialEndDate OptNilString `json:"trial_end_date"`
+	// Category for spending analysis, defaults to the category of the catalog entry.
+	Category OptString `json:"category"`
+	// Free-form tags, matched ignoring case.
+	Tags  []string     `json:"tags"`
+	Split OptSplitRule `json:"split"`
+	// Users sharing the price by split, the subscription is not shared without members.
+	Members []SubscriptionMemberInput `json:"members"`
+	// Promotions reducing the price in the months they cover, they must not overlap.
+	Discounts []SubscriptionDiscount `json:"discounts"`
+}
+
+// GetServiceName returns the value of ServiceName.
+func (s *SubscriptionCreate) GetServiceName() string {
+	return s.ServiceName
+}
+
+// GetPrice returns the value of Price.
+func (s *SubscriptionCreate) GetPrice() OptMoney {
+	return s.Price
+}
+
+// GetTaxRate returns the value of TaxRate.
+func (s *SubscriptionCreate) GetTaxRate() OptInt {
+	return s.TaxRate
+}
+
+// GetTaxInclusive returns the value of TaxInclusive.
+func (s *SubscriptionCreate) GetTaxInclusive() OptBool {
+	return s.TaxInclusive
+}
+
+// GetUserID returns the value of UserID.
+func (s *SubscriptionCreate) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *SubscriptionCreate) GetStartDate() string {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *SubscriptionCreate) GetEndDate() OptNilString {
+	return s.EndDate
+}
+
+// GetTrialEndDate returns the value of TrialEndDate.
+func (s *SubscriptionCreate) GetTrialEndDate() OptNilString {
+	return s.TrialEndDate
+}
+
+// GetCategory returns the value of Category.
+func (s *SubscriptionCreate) GetCategory() OptString {
+	return s.Category
+}
+
+// GetTags returns the value of Tags.
+func (s *SubscriptionCreate) GetTags() []string {
+	return s.Tags
+}
+
+// GetSplit returns the value of Split.
+func (s *SubscriptionCreate) GetSplit() OptSplitRule {
+	return s.Split
+}
+
+// GetMembers returns the value of Members.
+func (s *SubscriptionCreate) GetMembers() []SubscriptionMemberInput {
+	return s.Members
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *SubscriptionCreate) GetDiscounts() []SubscriptionDiscount {
+	return s.Discounts
+}
+
+// SetServiceName sets the value of ServiceName.
+func (s *SubscriptionCreate) SetServiceName(val string) {
+	s.ServiceName = val
+}
+
+// SetPrice sets the value of Price.
+func (s *SubscriptionCreate) SetPrice(val OptMoney) {
+	s.Price = val
+}
+
+// SetTaxRate sets the value of TaxRate.
+func (s *SubscriptionCreate) SetTaxRate(val OptInt) {
+	s.TaxRate = val
+}
+
+// SetTaxInclusive sets the value of TaxInclusive.
+func (s *SubscriptionCreate) SetTaxInclusive(val OptBool) {
+	s.TaxInclusive = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *SubscriptionCreate) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *SubscriptionCreate) SetStartDate(val string) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *SubscriptionCreate) SetEndDate(val OptNilString) {
+	s.EndDate = val
+}
+
+// SetTrialEndDate sets the value of TrialEndDate.
+func (s *SubscriptionCreate) SetTrialEndDate(val OptNilString) {
+	s.TrialEndDate = val
+}
+
+// SetCategory sets the value of Category.
+func (s *SubscriptionCreate) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetTags sets the value of Tags.
+func (s *SubscriptionCreate) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetSplit sets the value of Split.
+func (s *SubscriptionCreate) SetSplit(val OptSplitRule) {
+	s.Split = val
+}
+
+// SetMembers sets the value of Members.
+func (s *SubscriptionCreate) SetMembers(val []SubscriptionMemberInput) {
+	s.Members = val
+}
+
+// SetDiscounts sets the value of Discounts.
+func (s *SubscriptionCreate) SetDiscounts(val []SubscriptionDiscount) {
+	s.Discounts = val
+}
+
+// Ref: #/components/schemas/SubscriptionDiscount
+type SubscriptionDiscount struct {
+	// Percentage takes value percent off the price, fixed takes the amount value off the price, at most
+	// down to 0.
+	Kind SubscriptionDiscountKind `json:"kind"`
+	// Percent between 1 and 100 for percentage, monthly amount in minor units of the price for fixed.
+	Value int64 `json:"value"`
+	// First discounted month, within the subscription period.
+	StartDate string `json:"start_date"`
+	// Last discounted month, null while the discount lasts until the subscription ends.
+	EndDate OptNilString `json:"end_date"`
+}
+
+// GetKind returns the value of Kind.
+func (s *SubscriptionDiscount) GetKind() SubscriptionDiscountKind {
+	return s.Kind
+}
+
+// GetValue returns the value of Value.
+func (s *SubscriptionDiscount) GetValue() int64 {
+	return s.Value
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *SubscriptionDiscount) GetStartDate() string {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *SubscriptionDiscount) GetEndDate() OptNilString {
+	return s.EndDate
+}
+
+// SetKind sets the value of Kind.
+func (s *SubscriptionDiscount) SetKind(val SubscriptionDiscountKind) {
+	s.Kind = val
+}
+
+// SetValue sets the value of Value.
+func (s *SubscriptionDiscount) SetValue(val int64) {
+	s.Value = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *SubscriptionDiscount) SetStartDate(val string) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *SubscriptionDiscount) SetEndDate(val OptNilString) {
+	s.EndDate = val
+}
+
+// Percentage takes value percent off the price, fixed takes the amount value off the price, at most
+// down to 0.
+type SubscriptionDiscountKind string
+
+const (
+	SubscriptionDiscountKindPercentage SubscriptionDiscountKind = "percentage"
+	SubscriptionDiscountKindFixed      SubscriptionDiscountKind = "fixed"
+)
+
+// AllValues returns all SubscriptionDiscountKind values.
+func (SubscriptionDiscountKind) AllValues() []SubscriptionDiscountKind {
+	return []SubscriptionDiscountKind{
+		SubscriptionDiscountKindPercentage,
+		SubscriptionDiscountKindFixed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionDiscountKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionDiscountKindPercentage:
+		return []byte(s), nil
+	case SubscriptionDiscountKindFixed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionDiscountKind) UnmarshalText(data []byte) error {
+	switch SubscriptionDiscountKind(data) {
+	case SubscriptionDiscountKindPercentage:
+		*s = SubscriptionDiscountKindPercentage
+		return nil
+	case SubscriptionDiscountKindFixed:
+		*s = SubscriptionDiscountKindFixed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SubscriptionMember
+type SubscriptionMember struct {
+	UserID uuid.UUID `json:"user_id"`
+	Share  int64     `json:"share"`
+	// Monthly part of the price in its minor units the member bears.
+	Amount int64 `json:"amount"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *SubscriptionMember) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetShare returns the value of Share.
+func (s *SubscriptionMember) GetShare() int64 {
+	return s.Share
+}
+
+// GetAmount returns the value of Amount.
+func (s *SubscriptionMember) GetAmount() int64 {
+	return s.Amount
+}
+
+// SetUserID sets the value of UserID.
+func (s *SubscriptionMember) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetShare sets the value of Share.
+func (s *SubscriptionMember) SetShare(val int64) {
+	s.Share = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *SubscriptionMember) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/SubscriptionMemberInput
+type SubscriptionMemberInput struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Percent for a percentage split, monthly amount in minor units of the price for a fixed split,
+	// omitted for an equal split.
+	Share OptInt64 `json:"share"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *SubscriptionMemberInput) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetShare returns the value of Share.
+func (s *SubscriptionMemberInput) GetShare() OptInt64 {
+	return s.Share
+}
+
+// SetUserID sets the value of UserID.
+func (s *SubscriptionMemberInput) SetUserID(val uuid.UUID) {
+	s.UserID = val
 }
 
-// Ref: #/components/schemas/Subscription
-type Subscription struct {
-	ID          OptUUID      `json:"id"`
-	ServiceName OptString    `json:"service_name"`
-	Price       OptInt32     `json:"price"`
-	UserID      OptUUID      `json:"user_id"`
-	StartDate   OptString    `json:"start_date"`
-	EndDate     OptNilString `json:"end_date"`
-	CreatedAt   OptDateTime  `json:"created_at"`
-	UpdatedAt   OptDateTime  `json:"updated_at"`
+// SetShare sets the value of Share.
+func (s *SubscriptionMemberInput) SetShare(val OptInt64) {
+	s.Share = val
 }
 
-// GetID returns the value of ID.
-func (s *Subscription) GetID() OptUUID {
-	return s.ID
+// Ref: #/components/schemas/SubscriptionPatch
+type SubscriptionPatch struct {
+	ServiceName OptString `json:"service_name"`
+	Price       OptMoney  `json:"price"`
+	// VAT rate in basis points, 2000 is 20%.
+	TaxRate OptInt `json:"tax_rate"`
+	// Whether the price includes the tax, otherwise the tax is added on top of it.
+	TaxInclusive OptBool      `json:"tax_inclusive"`
+	EndDate      OptNilString `json:"end_date"`
+	// Last month of the free trial, null removes the trial.
+	TrialEndDate OptNilString `json:"trial_end_date"`
+	Category     OptString    `json:"category"`
+	// Replaces the current tags, an empty array removes them.
+	Tags  []string     `json:"tags"`
+	Split OptSplitRule `json:"split"`
+	// Replaces the current members, an empty array ends the sharing.
+	Members []SubscriptionMemberInput `json:"members"`
+	// Replaces the current discounts, an empty array removes them.
+	Discounts []SubscriptionDiscount `json:"discounts"`
 }
 
 // GetServiceName returns the value of ServiceName.
-func (s *Subscription) GetServiceName() OptString {
+func (s *SubscriptionPatch) GetServiceName() OptString {
 	return s.ServiceName
 }
 
 // GetPrice returns the value of Price.
-func (s *Subscription) GetPrice() OptInt32 {
+func (s *SubscriptionPatch) GetPrice() OptMoney {
 	return s.Price
 }
 
-// GetUserID returns the value of UserID.
-func (s *Subscription) GetUserID() OptUUID {
-	return s.UserID
+// GetTaxRate returns the value of TaxRate.
+func (s *SubscriptionPatch) GetTaxRate() OptInt {
+	return s.TaxRate
 }
 
-// GetStartDate returns the value of StartDate.
-func (s *Subscription) GetStartDate() OptString {
-	return s.StartDate
+// GetTaxInclusive returns the value of TaxInclusive.
+func (s *SubscriptionPatch) GetTaxInclusive() OptBool {
+	return s.TaxInclusive
 }
 
 // GetEndDate returns the value of EndDate.
-func (s *Subscription) GetEndDate() OptNilString {
+func (s *SubscriptionPatch) GetEndDate() OptNilString {
 	return s.EndDate
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Subscription) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetTrialEndDate returns the value of TrialEndDate.
+func (s *SubscriptionPatch) GetTrialEndDate() OptNilString {
+	return s.TrialEndDate
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Subscription) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetCategory returns the value of Category.
+func (s *SubscriptionPatch) GetCategory() OptString {
+	return s.Category
 }
 
-// SetID sets the value of ID.
-func (s *Subscription) SetID(val OptUUID) {
-	s.ID = val
+// GetTags returns the value of Tags.
+func (s *SubscriptionPatch) GetTags() []string {
+	return s.Tags
+}
+
+// GetSplit returns the value of Split.
+func (s *SubscriptionPatch) GetSplit() OptSplitRule {
+	return s.Split
+}
+
+// GetMembers returns the value of Members.
+func (s *SubscriptionPatch) GetMembers() []SubscriptionMemberInput {
+	return s.Members
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *SubscriptionPatch) GetDiscounts() []SubscriptionDiscount {
+	return s.Discounts
 }
 
 // SetServiceName sets the value of ServiceName.
-func (s *Subscription) SetServiceName(val OptString) {
+func (s *SubscriptionPatch) SetServiceName(val OptString) {
 	s.ServiceName = val
 }
 
 // SetPrice sets the value of Price.
-func (s *Subscription) SetPrice(val OptInt32) {
+func (s *SubscriptionPatch) SetPrice(val OptMoney) {
 	s.Price = val
 }
 
-// SetUserID sets the value of UserID.
-func (s *Subscription) SetUserID(val OptUUID) {
-	s.UserID = val
+// SetTaxRate sets the value of TaxRate.
+func (s *SubscriptionPatch) SetTaxRate(val OptInt) {
+	s.TaxRate = val
 }
 
-// SetStartDate sets the value of StartDate.
-func (s *Subscription) SetStartDate(val OptString) {
-	s.StartDate = val
+// SetTaxInclusive sets the value of TaxInclusive.
+func (s *SubscriptionPatch) SetTaxInclusive(val OptBool) {
+	s.TaxInclusive = val
 }
 
 // SetEndDate sets the value of EndDate.
-func (s *Subscription) SetEndDate(val OptNilString) {
+func (s *SubscriptionPatch) SetEndDate(val OptNilString) {
 	s.EndDate = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Subscription) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetTrialEndDate sets the value of TrialEndDate.
+func (s *SubscriptionPatch) SetTrialEndDate(val OptNilString) {
+	s.TrialEndDate = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Subscription) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetCategory sets the value of Category.
+func (s *SubscriptionPatch) SetCategory(val OptString) {
+	s.Category = val
 }
 
-func (*Subscription) subscriptionsIDGetRes()   {}
-func (*Subscription) subscriptionsIDPatchRes() {}
-func (*Subscription) subscriptionsIDPutRes()   {}
-func (*Subscription) subscriptionsPostRes()    {}
+// SetTags sets the value of Tags.
+func (s *SubscriptionPatch) SetTags(val []string) {
+	s.Tags = val
+}
 
-// Ref: #/components/schemas/SubscriptionCreate
-type SubscriptionCreate struct {
-	ServiceName string    `json:"service_name"`
-	Price       int32     `json:"price"`
-	UserID      uuid.UUID `json:"user_id"`
-	// Date in MM-YYYY format.
-	StartDate string `json:"start_date"`
-	// Optional end date in MM-YYYY format.
-	EndDate OptNilString `json:"end_date"`
+// SetSplit sets the value of Split.
+func (s *SubscriptionPatch) SetSplit(val OptSplitRule) {
+	s.Split = val
 }
 
-// GetServiceName returns the value of ServiceName.
-func (s *SubscriptionCreate) GetServiceName() string {
-	return s.ServiceName
+// SetMembers sets the value of Members.
+func (s *SubscriptionPatch) SetMembers(val []SubscriptionMemberInput) {
+	s.Members = val
 }
 
-// GetPrice returns the value of Price.
-func (s *SubscriptionCreate) GetPrice() int32 {
-	return s.Price
+// SetDiscounts sets the value of Discounts.
+func (s *SubscriptionPatch) SetDiscounts(val []SubscriptionDiscount) {
+	s.Discounts = val
 }
 
-// GetUserID returns the value of UserID.
-func (s *SubscriptionCreate) GetUserID() uuid.UUID {
-	return s.UserID
+// Ref: #/components/schemas/SubscriptionPause
+type SubscriptionPause struct {
+	// First paused month.
+	StartDate string `json:"start_date"`
+	// Last paused month, null while the subscription is paused until resumed.
+	EndDate OptNilString `json:"end_date"`
 }
 
 // GetStartDate returns the value of StartDate.
-func (s *SubscriptionCreate) GetStartDate() string {
+func (s *SubscriptionPause) GetStartDate() string {
 	return s.StartDate
 }
 
 // GetEndDate returns the value of EndDate.
-func (s *SubscriptionCreate) GetEndDate() OptNilString {
+func (s *SubscriptionPause) GetEndDate() OptNilString {
 	return s.EndDate
 }
 
-// SetServiceName sets the value of ServiceName.
-func (s *SubscriptionCreate) SetServiceName(val string) {
-	s.ServiceName = val
+// SetStartDate sets the value of StartDate.
+func (s *SubscriptionPause) SetStartDate(val string) {
+	s.StartDate = val
 }
 
-// SetPrice sets the value of Price.
-func (s *SubscriptionCreate) SetPrice(val int32) {
-	s.Price = val
+// SetEndDate sets the value of EndDate.
+func (s *SubscriptionPause) SetEndDate(val OptNilString) {
+	s.EndDate = val
 }
 
-// SetUserID sets the value of UserID.
-func (s *SubscriptionCreate) SetUserID(val uuid.UUID) {
-	s.UserID = val
+// Ref: #/components/schemas/SubscriptionPauseRequest
+type SubscriptionPauseRequest struct {
+	// First paused month, defaults to the current month.
+	StartDate OptString `json:"start_date"`
+	// Last paused month, the pause lasts until the subscription is resumed when omitted.
+	EndDate OptString `json:"end_date"`
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *SubscriptionPauseRequest) GetStartDate() OptString {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *SubscriptionPauseRequest) GetEndDate() OptString {
+	return s.EndDate
 }
 
 // SetStartDate sets the value of StartDate.
-func (s *SubscriptionCreate) SetStartDate(val string) {
+func (s *SubscriptionPauseRequest) SetStartDate(val OptString) {
 	s.StartDate = val
 }
 
 // SetEndDate sets the value of EndDate.
-func (s *SubscriptionCreate) SetEndDate(val OptNilString) {
+func (s *SubscriptionPauseRequest) SetEndDate(val OptString) {
 	s.EndDate = val
 }
 
-// Ref: #/components/schemas/SubscriptionPatch
-type SubscriptionPatch struct {
-	ServiceName OptString    `json:"service_name"`
-	Price       OptInt32     `json:"price"`
-	EndDate     OptNilString `json:"end_date"`
+// Ref: #/components/schemas/SubscriptionResumeRequest
+type SubscriptionResumeRequest struct {
+	// First month charged again, defaults to the current month.
+	Date OptString `json:"date"`
 }
 
-// GetServiceName returns the value of ServiceName.
-func (s *SubscriptionPatch) GetServiceName() OptString {
-	return s.ServiceName
+// GetDate returns the value of Date.
+func (s *SubscriptionResumeRequest) GetDate() OptString {
+	return s.Date
 }
 
-// GetPrice returns the value of Price.
-func (s *SubscriptionPatch) GetPrice() OptInt32 {
-	return s.Price
+// SetDate sets the value of Date.
+func (s *SubscriptionResumeRequest) SetDate(val OptString) {
+	s.Date = val
 }
 
-// GetEndDate returns the value of EndDate.
-func (s *SubscriptionPatch) GetEndDate() OptNilString {
-	return s.EndDate
-}
+// Lifecycle state of the subscription in the current month.
+// Ref: #/components/schemas/SubscriptionStatus
+type SubscriptionStatus string
 
-// SetServiceName sets the value of ServiceName.
-func (s *SubscriptionPatch) SetServiceName(val OptString) {
-	s.ServiceName = val
+const (
+	SubscriptionStatusTrial                 SubscriptionStatus = "trial"
+	SubscriptionStatusActive                SubscriptionStatus = "active"
+	SubscriptionStatusPaused                SubscriptionStatus = "paused"
+	SubscriptionStatusCancellationScheduled SubscriptionStatus = "cancellation_scheduled"
+	SubscriptionStatusEnded                 SubscriptionStatus = "ended"
+)
+
+// AllValues returns all SubscriptionStatus values.
+func (SubscriptionStatus) AllValues() []SubscriptionStatus {
+	return []SubscriptionStatus{
+		SubscriptionStatusTrial,
+		SubscriptionStatusActive,
+		SubscriptionStatusPaused,
+		SubscriptionStatusCancellationScheduled,
+		SubscriptionStatusEnded,
+	}
 }
 
-// SetPrice sets the value of Price.
-func (s *SubscriptionPatch) SetPrice(val OptInt32) {
-	s.Price = val
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionStatusTrial:
+		return []byte(s), nil
+	case SubscriptionStatusActive:
+		return []byte(s), nil
+	case SubscriptionStatusPaused:
+		return []byte(s), nil
+	case SubscriptionStatusCancellationScheduled:
+		return []byte(s), nil
+	case SubscriptionStatusEnded:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetEndDate sets the value of EndDate.
-func (s *SubscriptionPatch) SetEndDate(val OptNilString) {
-	s.EndDate = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionStatus) UnmarshalText(data []byte) error {
+	switch SubscriptionStatus(data) {
+	case SubscriptionStatusTrial:
+		*s = SubscriptionStatusTrial
+		return nil
+	case SubscriptionStatusActive:
+		*s = SubscriptionStatusActive
+		return nil
+	case SubscriptionStatusPaused:
+		*s = SubscriptionStatusPaused
+		return nil
+	case SubscriptionStatusCancellationScheduled:
+		*s = SubscriptionStatusCancellationScheduled
+		return nil
+	case SubscriptionStatusEnded:
+		*s = SubscriptionStatusEnded
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
 // Ref: #/components/schemas/SubscriptionUpdate
 type SubscriptionUpdate struct {
-	ServiceName string       `json:"service_name"`
-	Price       int32        `json:"price"`
-	UserID      uuid.UUID    `json:"user_id"`
-	StartDate   string       `json:"start_date"`
-	EndDate     OptNilString `json:"end_date"`
+	ServiceName string `json:"service_name"`
+	Price       Money  `json:"price"`
+	// VAT rate in basis points, 2000 is 20%.
+	TaxRate OptInt `json:"tax_rate"`
+	// Whether the price includes the tax, otherwise the tax is added on top of it.
+	TaxInclusive OptBool      `json:"tax_inclusive"`
+	UserID       uuid.UUID    `json:"user_id"`
+	StartDate    string       `json:"start_date"`
+	EndDate      OptNilString `json:"end_date"`
+	TrialEndDate OptNilString `json:"trial_end_date"`
+	Category     OptString    `json:"category"`
+	Tags         []string     `json:"tags"`
+	Split        OptSplitRule `json:"split"`
+	// Users sharing the price by split, the subscription is not shared without members.
+	Members []SubscriptionMemberInput `json:"members"`
+	// Promotions reducing the price in the months they cover, they must not overlap.
+	Discounts []SubscriptionDiscount `json:"discounts"`
 }
 
 // GetServiceName returns the value of ServiceName.
@@ -845,10 +2813,20 @@ func (s *SubscriptionUpdate) GetServiceName() string {
 }
 
 // GetPrice returns the value of Price.
-func (s *SubscriptionUpdate) GetPrice() int32 {
+func (s *SubscriptionUpdate) GetPrice() Money {
 	return s.Price
 }
 
+// GetTaxRate returns the value of TaxRate.
+func (s *SubscriptionUpdate) GetTaxRate() OptInt {
+	return s.TaxRate
+}
+
+// GetTaxInclusive returns the value of TaxInclusive.
+func (s *SubscriptionUpdate) GetTaxInclusive() OptBool {
+	return s.TaxInclusive
+}
+
 // GetUserID returns the value of UserID.
 func (s *SubscriptionUpdate) GetUserID() uuid.UUID {
 	return s.UserID
@@ -864,16 +2842,56 @@ func (s *SubscriptionUpdate) GetEndDate() OptNilString {
 	return s.EndDate
 }
 
+// GetTrialEndDate returns the value of TrialEndDate.
+func (s *SubscriptionUpdate) GetTrialEndDate() OptNilString {
+	return s.TrialEndDate
+}
+
+// GetCategory returns the value of Category.
+func (s *SubscriptionUpdate) GetCategory() OptString {
+	return s.Category
+}
+
+// GetTags returns the value of Tags.
+func (s *SubscriptionUpdate) GetTags() []string {
+	return s.Tags
+}
+
+// GetSplit returns the value of Split.
+func (s *SubscriptionUpdate) GetSplit() OptSplitRule {
+	return s.Split
+}
+
+// GetMembers returns the value of Members.
+func (s *SubscriptionUpdate) GetMembers() []SubscriptionMemberInput {
+	return s.Members
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *SubscriptionUpdate) GetDiscounts() []SubscriptionDiscount {
+	return s.Discounts
+}
+
 // SetServiceName sets the value of ServiceName.
 func (s *SubscriptionUpdate) SetServiceName(val string) {
 	s.ServiceName = val
 }
 
 // SetPrice sets the value of Price.
-func (s *SubscriptionUpdate) SetPrice(val int32) {
+func (s *SubscriptionUpdate) SetPrice(val Money) {
 	s.Price = val
 }
 
+// SetTaxRate sets the value of TaxRate.
+func (s *SubscriptionUpdate) SetTaxRate(val OptInt) {
+	s.TaxRate = val
+}
+
+// SetTaxInclusive sets the value of TaxInclusive.
+func (s *SubscriptionUpdate) SetTaxInclusive(val OptBool) {
+	s.TaxInclusive = val
+}
+
 // SetUserID sets the value of UserID.
 func (s *SubscriptionUpdate) SetUserID(val uuid.UUID) {
 	s.UserID = val
@@ -889,11 +2907,41 @@ func (s *SubscriptionUpdate) SetEndDate(val OptNilString) {
 	s.EndDate = val
 }
 
-type SubscriptionsGetBadRequest Error
+// SetTrialEndDate sets the value of TrialEndDate.
+func (s *SubscriptionUpdate) SetTrialEndDate(val OptNilString) {
+	s.TrialEndDate = val
+}
+
+// SetCategory sets the value of Category.
+func (s *SubscriptionUpdate) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetTags sets the value of Tags.
+func (s *SubscriptionUpdate) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetSplit sets the value of Split.
+func (s *SubscriptionUpdate) SetSplit(val OptSplitRule) {
+	s.Split = val
+}
+
+// SetMembers sets the value of Members.
+func (s *SubscriptionUpdate) SetMembers(val []SubscriptionMemberInput) {
+	s.Members = val
+}
+
+// SetDiscounts sets the value of Discounts.
+func (s *SubscriptionUpdate) SetDiscounts(val []SubscriptionDiscount) {
+	s.Discounts = val
+}
+
+type SubscriptionsGetBadRequest Problem
 
 func (*SubscriptionsGetBadRequest) subscriptionsGetRes() {}
 
-type SubscriptionsGetInternalServerError Error
+type SubscriptionsGetInternalServerError Problem
 
 func (*SubscriptionsGetInternalServerError) subscriptionsGetRes() {}
 
@@ -924,7 +2972,23 @@ func (s *SubscriptionsGetOK) SetPagination(val OptPagination) {
 
 func (*SubscriptionsGetOK) subscriptionsGetRes() {}
 
-type SubscriptionsIDDeleteInternalServerError Error
+type SubscriptionsIDCancelPostBadRequest Problem
+
+func (*SubscriptionsIDCancelPostBadRequest) subscriptionsIDCancelPostRes() {}
+
+type SubscriptionsIDCancelPostConflict Problem
+
+func (*SubscriptionsIDCancelPostConflict) subscriptionsIDCancelPostRes() {}
+
+type SubscriptionsIDCancelPostInternalServerError Problem
+
+func (*SubscriptionsIDCancelPostInternalServerError) subscriptionsIDCancelPostRes() {}
+
+type SubscriptionsIDCancelPostNotFound Problem
+
+func (*SubscriptionsIDCancelPostNotFound) subscriptionsIDCancelPostRes() {}
+
+type SubscriptionsIDDeleteInternalServerError Problem
 
 func (*SubscriptionsIDDeleteInternalServerError) subscriptionsIDDeleteRes() {}
 
@@ -933,69 +2997,226 @@ type SubscriptionsIDDeleteNoContent struct{}
 
 func (*SubscriptionsIDDeleteNoContent) subscriptionsIDDeleteRes() {}
 
-type SubscriptionsIDDeleteNotFound Error
+type SubscriptionsIDDeleteNotFound Problem
 
 func (*SubscriptionsIDDeleteNotFound) subscriptionsIDDeleteRes() {}
 
-type SubscriptionsIDGetInternalServerError Error
+type SubscriptionsIDGetInternalServerError Problem
 
 func (*SubscriptionsIDGetInternalServerError) subscriptionsIDGetRes() {}
 
-type SubscriptionsIDGetNotFound Error
+type SubscriptionsIDGetNotFound Problem
 
 func (*SubscriptionsIDGetNotFound) subscriptionsIDGetRes() {}
 
-type SubscriptionsIDPatchBadRequest Error
+type SubscriptionsIDPatchBadRequest Problem
 
 func (*SubscriptionsIDPatchBadRequest) subscriptionsIDPatchRes() {}
 
-type SubscriptionsIDPatchInternalServerError Error
+type SubscriptionsIDPatchConflict Problem
+
+func (*SubscriptionsIDPatchConflict) subscriptionsIDPatchRes() {}
+
+type SubscriptionsIDPatchInternalServerError Problem
 
 func (*SubscriptionsIDPatchInternalServerError) subscriptionsIDPatchRes() {}
 
-type SubscriptionsIDPatchNotFound Error
+type SubscriptionsIDPatchNotFound Problem
 
 func (*SubscriptionsIDPatchNotFound) subscriptionsIDPatchRes() {}
 
-type SubscriptionsIDPutBadRequest Error
+type SubscriptionsIDPausePostBadRequest Problem
+
+func (*SubscriptionsIDPausePostBadRequest) subscriptionsIDPausePostRes() {}
+
+type SubscriptionsIDPausePostConflict Problem
+
+func (*SubscriptionsIDPausePostConflict) subscriptionsIDPausePostRes() {}
+
+type SubscriptionsIDPausePostInternalServerError Problem
+
+func (*SubscriptionsIDPausePostInternalServerError) subscriptionsIDPausePostRes() {}
+
+type SubscriptionsIDPausePostNotFound Problem
+
+func (*SubscriptionsIDPausePostNotFound) subscriptionsIDPausePostRes() {}
+
+type SubscriptionsIDPutBadRequest Problem
 
 func (*SubscriptionsIDPutBadRequest) subscriptionsIDPutRes() {}
 
-type SubscriptionsIDPutInternalServerError Error
+type SubscriptionsIDPutConflict Problem
+
+func (*SubscriptionsIDPutConflict) subscriptionsIDPutRes() {}
+
+type SubscriptionsIDPutInternalServerError Problem
 
 func (*SubscriptionsIDPutInternalServerError) subscriptionsIDPutRes() {}
 
-type SubscriptionsIDPutNotFound Error
+type SubscriptionsIDPutNotFound Problem
 
 func (*SubscriptionsIDPutNotFound) subscriptionsIDPutRes() {}
 
-type SubscriptionsPostBadRequest Error
+type SubscriptionsIDResumePostBadRequest Problem
+
+func (*SubscriptionsIDResumePostBadRequest) subscriptionsIDResumePostRes() {}
+
+type SubscriptionsIDResumePostConflict Problem
+
+func (*SubscriptionsIDResumePostConflict) subscriptionsIDResumePostRes() {}
+
+type SubscriptionsIDResumePostInternalServerError Problem
+
+func (*SubscriptionsIDResumePostInternalServerError) subscriptionsIDResumePostRes() {}
+
+type SubscriptionsIDResumePostNotFound Problem
+
+func (*SubscriptionsIDResumePostNotFound) subscriptionsIDResumePostRes() {}
+
+type SubscriptionsPostBadRequest Problem
 
 func (*SubscriptionsPostBadRequest) subscriptionsPostRes() {}
 
-type SubscriptionsPostInternalServerError Error
+type SubscriptionsPostConflict Problem
+
+func (*SubscriptionsPostConflict) subscriptionsPostRes() {}
+
+type SubscriptionsPostInternalServerError Problem
 
 func (*SubscriptionsPostInternalServerError) subscriptionsPostRes() {}
 
-type SubscriptionsSummaryTotalCostGetBadRequest Error
+type SubscriptionsSummaryTotalCostGetAttribution string
+
+const (
+	SubscriptionsSummaryTotalCostGetAttributionShare SubscriptionsSummaryTotalCostGetAttribution = "share"
+	SubscriptionsSummaryTotalCostGetAttributionPayer SubscriptionsSummaryTotalCostGetAttribution = "payer"
+)
+
+// AllValues returns all SubscriptionsSummaryTotalCostGetAttribution values.
+func (SubscriptionsSummaryTotalCostGetAttribution) AllValues() []SubscriptionsSummaryTotalCostGetAttribution {
+	return []SubscriptionsSummaryTotalCostGetAttribution{
+		SubscriptionsSummaryTotalCostGetAttributionShare,
+		SubscriptionsSummaryTotalCostGetAttributionPayer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionsSummaryTotalCostGetAttribution) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionsSummaryTotalCostGetAttributionShare:
+		return []byte(s), nil
+	case SubscriptionsSummaryTotalCostGetAttributionPayer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionsSummaryTotalCostGetAttribution) UnmarshalText(data []byte) error {
+	switch SubscriptionsSummaryTotalCostGetAttribution(data) {
+	case SubscriptionsSummaryTotalCostGetAttributionShare:
+		*s = SubscriptionsSummaryTotalCostGetAttributionShare
+		return nil
+	case SubscriptionsSummaryTotalCostGetAttributionPayer:
+		*s = SubscriptionsSummaryTotalCostGetAttributionPayer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SubscriptionsSummaryTotalCostGetBadRequest Problem
 
 func (*SubscriptionsSummaryTotalCostGetBadRequest) subscriptionsSummaryTotalCostGetRes() {}
 
-type SubscriptionsSummaryTotalCostGetInternalServerError Error
+type SubscriptionsSummaryTotalCostGetGroupBy string
+
+const (
+	SubscriptionsSummaryTotalCostGetGroupByCategory SubscriptionsSummaryTotalCostGetGroupBy = "category"
+	SubscriptionsSummaryTotalCostGetGroupByTag      SubscriptionsSummaryTotalCostGetGroupBy = "tag"
+)
+
+// AllValues returns all SubscriptionsSummaryTotalCostGetGroupBy values.
+func (SubscriptionsSummaryTotalCostGetGroupBy) AllValues() []SubscriptionsSummaryTotalCostGetGroupBy {
+	return []SubscriptionsSummaryTotalCostGetGroupBy{
+		SubscriptionsSummaryTotalCostGetGroupByCategory,
+		SubscriptionsSummaryTotalCostGetGroupByTag,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionsSummaryTotalCostGetGroupBy) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionsSummaryTotalCostGetGroupByCategory:
+		return []byte(s), nil
+	case SubscriptionsSummaryTotalCostGetGroupByTag:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionsSummaryTotalCostGetGroupBy) UnmarshalText(data []byte) error {
+	switch SubscriptionsSummaryTotalCostGetGroupBy(data) {
+	case SubscriptionsSummaryTotalCostGetGroupByCategory:
+		*s = SubscriptionsSummaryTotalCostGetGroupByCategory
+		return nil
+	case SubscriptionsSummaryTotalCostGetGroupByTag:
+		*s = SubscriptionsSummaryTotalCostGetGroupByTag
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SubscriptionsSummaryTotalCostGetInternalServerError Problem
 
 func (*SubscriptionsSummaryTotalCostGetInternalServerError) subscriptionsSummaryTotalCostGetRes() {}
 
 type SubscriptionsSummaryTotalCostGetOK struct {
-	TotalCost      OptInt                                              `json:"total_cost"`
+	// Charged cost after discounts including tax in minor units of currency, net_cost + tax.
+	TotalCost OptInt64 `json:"total_cost"`
+	// Charged cost after discounts without tax in minor units of currency.
+	NetCost OptInt64 `json:"net_cost"`
+	// Tax contained in or added to the cost in minor units of currency, rounded per subscription.
+	Tax OptInt64 `json:"tax"`
+	// Amount the discounts took off the cost in minor units of currency.
+	Discount OptInt64 `json:"discount"`
+	// ISO 4217 currency of the amounts.
+	Currency       OptString                                           `json:"currency"`
 	Period         OptSubscriptionsSummaryTotalCostGetOKPeriod         `json:"period"`
 	FilterCriteria OptSubscriptionsSummaryTotalCostGetOKFilterCriteria `json:"filter_criteria"`
+	// Cost per category or tag ordered by cost, only present when group_by is set.
+	Breakdown []CostBreakdownItem `json:"breakdown"`
 }
 
 // GetTotalCost returns the value of TotalCost.
-func (s *SubscriptionsSummaryTotalCostGetOK) GetTotalCost() OptInt {
+func (s *SubscriptionsSummaryTotalCostGetOK) GetTotalCost() OptInt64 {
 	return s.TotalCost
 }
 
+// GetNetCost returns the value of NetCost.
+func (s *SubscriptionsSummaryTotalCostGetOK) GetNetCost() OptInt64 {
+	return s.NetCost
+}
+
+// GetTax returns the value of Tax.
+func (s *SubscriptionsSummaryTotalCostGetOK) GetTax() OptInt64 {
+	return s.Tax
+}
+
+// GetDiscount returns the value of Discount.
+func (s *SubscriptionsSummaryTotalCostGetOK) GetDiscount() OptInt64 {
+	return s.Discount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *SubscriptionsSummaryTotalCostGetOK) GetCurrency() OptString {
+	return s.Currency
+}
+
 // GetPeriod returns the value of Period.
 func (s *SubscriptionsSummaryTotalCostGetOK) GetPeriod() OptSubscriptionsSummaryTotalCostGetOKPeriod {
 	return s.Period
@@ -1006,11 +3227,36 @@ func (s *SubscriptionsSummaryTotalCostGetOK) GetFilterCriteria() OptSubscription
 	return s.FilterCriteria
 }
 
+// GetBreakdown returns the value of Breakdown.
+func (s *SubscriptionsSummaryTotalCostGetOK) GetBreakdown() []CostBreakdownItem {
+	return s.Breakdown
+}
+
 // SetTotalCost sets the value of TotalCost.
-func (s *SubscriptionsSummaryTotalCostGetOK) SetTotalCost(val OptInt) {
+func (s *SubscriptionsSummaryTotalCostGetOK) SetTotalCost(val OptInt64) {
 	s.TotalCost = val
 }
 
+// SetNetCost sets the value of NetCost.
+func (s *SubscriptionsSummaryTotalCostGetOK) SetNetCost(val OptInt64) {
+	s.NetCost = val
+}
+
+// SetTax sets the value of Tax.
+func (s *SubscriptionsSummaryTotalCostGetOK) SetTax(val OptInt64) {
+	s.Tax = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *SubscriptionsSummaryTotalCostGetOK) SetDiscount(val OptInt64) {
+	s.Discount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *SubscriptionsSummaryTotalCostGetOK) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
 // SetPeriod sets the value of Period.
 func (s *SubscriptionsSummaryTotalCostGetOK) SetPeriod(val OptSubscriptionsSummaryTotalCostGetOKPeriod) {
 	s.Period = val
@@ -1021,11 +3267,19 @@ func (s *SubscriptionsSummaryTotalCostGetOK) SetFilterCriteria(val OptSubscripti
 	s.FilterCriteria = val
 }
 
+// SetBreakdown sets the value of Breakdown.
+func (s *SubscriptionsSummaryTotalCostGetOK) SetBreakdown(val []CostBreakdownItem) {
+	s.Breakdown = val
+}
+
 func (*SubscriptionsSummaryTotalCostGetOK) subscriptionsSummaryTotalCostGetRes() {}
 
 type SubscriptionsSummaryTotalCostGetOKFilterCriteria struct {
-	UserIds      []string `json:"user_ids"`
-	ServiceNames []string `json:"service_names"`
+	UserIds      []string  `json:"user_ids"`
+	ServiceNames []string  `json:"service_names"`
+	Categories   []string  `json:"categories"`
+	Tags         []string  `json:"tags"`
+	Attribution  OptString `json:"attribution"`
 }
 
 // GetUserIds returns the value of UserIds.
@@ -1038,6 +3292,21 @@ func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) GetServiceNames() []s
 	return s.ServiceNames
 }
 
+// GetCategories returns the value of Categories.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) GetCategories() []string {
+	return s.Categories
+}
+
+// GetTags returns the value of Tags.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) GetTags() []string {
+	return s.Tags
+}
+
+// GetAttribution returns the value of Attribution.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) GetAttribution() OptString {
+	return s.Attribution
+}
+
 // SetUserIds sets the value of UserIds.
 func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) SetUserIds(val []string) {
 	s.UserIds = val
@@ -1048,6 +3317,21 @@ func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) SetServiceNames(val [
 	s.ServiceNames = val
 }
 
+// SetCategories sets the value of Categories.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) SetCategories(val []string) {
+	s.Categories = val
+}
+
+// SetTags sets the value of Tags.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetAttribution sets the value of Attribution.
+func (s *SubscriptionsSummaryTotalCostGetOKFilterCriteria) SetAttribution(val OptString) {
+	s.Attribution = val
+}
+
 type SubscriptionsSummaryTotalCostGetOKPeriod struct {
 	StartDate OptString `json:"start_date"`
 	EndDate   OptString `json:"end_date"`
@@ -1072,3 +3356,30 @@ func (s *SubscriptionsSummaryTotalCostGetOKPeriod) SetStartDate(val OptString) {
 func (s *SubscriptionsSummaryTotalCostGetOKPeriod) SetEndDate(val OptString) {
 	s.EndDate = val
 }
+
+type SubscriptionsTrialsEndingGetOK struct {
+	Data       []Subscription `json:"data"`
+	Pagination OptPagination  `json:"pagination"`
+}
+
+// GetData returns the value of Data.
+func (s *SubscriptionsTrialsEndingGetOK) GetData() []Subscription {
+	return s.Data
+}
+
+// GetPagination returns the value of Pagination.
+func (s *SubscriptionsTrialsEndingGetOK) GetPagination() OptPagination {
+	return s.Pagination
+}
+
+// SetData sets the value of Data.
+func (s *SubscriptionsTrialsEndingGetOK) SetData(val []Subscription) {
+	s.Data = val
+}
+
+// SetPagination sets the value of Pagination.
+func (s *SubscriptionsTrialsEndingGetOK) SetPagination(val OptPagination) {
+	s.Pagination = val
+}
+
+func (*SubscriptionsTrialsEndingGetOK) subscriptionsTrialsEndingGetRes() {}
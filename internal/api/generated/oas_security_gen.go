@@ -0,0 +1,148 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleApiKeyAuth handles apiKeyAuth security.
+	// API key issued via /admin/api-keys.
+	HandleApiKeyAuth(ctx context.Context, operationName OperationName, t ApiKeyAuth) (context.Context, error)
+	// HandleBearerAuth handles bearerAuth security.
+	// JWT signed with HS256 or RS256 (JWKS).
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesApiKeyAuth = map[string][]string{
+	AdminAPIKeysGetOperation:                  []string{},
+	AdminAPIKeysIDDeleteOperation:             []string{},
+	AdminAPIKeysIDGetOperation:                []string{},
+	AdminAPIKeysIDPatchOperation:              []string{},
+	AdminAPIKeysPostOperation:                 []string{},
+	ServicesGetOperation:                      []string{},
+	ServicesIDDeleteOperation:                 []string{},
+	ServicesIDGetOperation:                    []string{},
+	ServicesIDPatchOperation:                  []string{},
+	ServicesPostOperation:                     []string{},
+	SubscriptionsGetOperation:                 []string{},
+	SubscriptionsIDCancelPostOperation:        []string{},
+	SubscriptionsIDDeleteOperation:            []string{},
+	SubscriptionsIDGetOperation:               []string{},
+	SubscriptionsIDPatchOperation:             []string{},
+	SubscriptionsIDPausePostOperation:         []string{},
+	SubscriptionsIDPutOperation:               []string{},
+	SubscriptionsIDResumePostOperation:        []string{},
+	SubscriptionsPostOperation:                []string{},
+	SubscriptionsSummaryTotalCostGetOperation: []string{},
+	SubscriptionsTrialsEndingGetOperation:     []string{},
+}
+
+func (s *Server) securityApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t ApiKeyAuth
+	const parameterName = "X-API-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesApiKeyAuth[operationName]
+	rctx, err := s.sec.HandleApiKeyAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+var operationRolesBearerAuth = map[string][]string{
+	AdminAPIKeysGetOperation:                  []string{},
+	AdminAPIKeysIDDeleteOperation:             []string{},
+	AdminAPIKeysIDGetOperation:                []string{},
+	AdminAPIKeysIDPatchOperation:              []string{},
+	AdminAPIKeysPostOperation:                 []string{},
+	ServicesGetOperation:                      []string{},
+	ServicesIDDeleteOperation:                 []string{},
+	ServicesIDGetOperation:                    []string{},
+	ServicesIDPatchOperation:                  []string{},
+	ServicesPostOperation:                     []string{},
+	SubscriptionsGetOperation:                 []string{},
+	SubscriptionsIDCancelPostOperation:        []string{},
+	SubscriptionsIDDeleteOperation:            []string{},
+	SubscriptionsIDGetOperation:               []string{},
+	SubscriptionsIDPatchOperation:             []string{},
+	SubscriptionsIDPausePostOperation:         []string{},
+	SubscriptionsIDPutOperation:               []string{},
+	SubscriptionsIDResumePostOperation:        []string{},
+	SubscriptionsPostOperation:                []string{},
+	SubscriptionsSummaryTotalCostGetOperation: []string{},
+	SubscriptionsTrialsEndingGetOperation:     []string{},
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// ApiKeyAuth provides apiKeyAuth security value.
+	// API key issued via /admin/api-keys.
+	ApiKeyAuth(ctx context.Context, operationName OperationName) (ApiKeyAuth, error)
+	// BearerAuth provides bearerAuth security value.
+	// JWT signed with HS256 or RS256 (JWKS).
+	BearerAuth(ctx context.Context, operationName OperationName) (BearerAuth, error)
+}
+
+func (s *Client) securityApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.ApiKeyAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"ApiKeyAuth\"")
+	}
+	req.Header.Set("X-API-Key", t.APIKey)
+	return nil
+}
+func (s *Client) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BearerAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BearerAuth\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
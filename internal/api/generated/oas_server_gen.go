@@ -8,12 +8,79 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AdminAPIKeysGet implements GET /admin/api-keys operation.
+	//
+	// Retrieve all API keys without their secrets.
+	//
+	// GET /admin/api-keys
+	AdminAPIKeysGet(ctx context.Context) (*AdminAPIKeysGetOK, error)
+	// AdminAPIKeysIDDelete implements DELETE /admin/api-keys/{id} operation.
+	//
+	// Revoke an API key, it can no longer be used for authentication.
+	//
+	// DELETE /admin/api-keys/{id}
+	AdminAPIKeysIDDelete(ctx context.Context, params AdminAPIKeysIDDeleteParams) (AdminAPIKeysIDDeleteRes, error)
+	// AdminAPIKeysIDGet implements GET /admin/api-keys/{id} operation.
+	//
+	// Retrieve a specific API key without its secret.
+	//
+	// GET /admin/api-keys/{id}
+	AdminAPIKeysIDGet(ctx context.Context, params AdminAPIKeysIDGetParams) (AdminAPIKeysIDGetRes, error)
+	// AdminAPIKeysIDPatch implements PATCH /admin/api-keys/{id} operation.
+	//
+	// Rename an API key or change its scopes.
+	//
+	// PATCH /admin/api-keys/{id}
+	AdminAPIKeysIDPatch(ctx context.Context, req *APIKeyPatch, params AdminAPIKeysIDPatchParams) (AdminAPIKeysIDPatchRes, error)
+	// AdminAPIKeysPost implements POST /admin/api-keys operation.
+	//
+	// Issue a new API key for service-to-service access. The key is returned only once.
+	//
+	// POST /admin/api-keys
+	AdminAPIKeysPost(ctx context.Context, req *APIKeyCreate) (AdminAPIKeysPostRes, error)
+	// ServicesGet implements GET /services operation.
+	//
+	// Retrieve all catalog entries ordered by name.
+	//
+	// GET /services
+	ServicesGet(ctx context.Context, params ServicesGetParams) (*ServicesGetOK, error)
+	// ServicesIDDelete implements DELETE /services/{id} operation.
+	//
+	// Remove a catalog entry no subscription references. Requires the admin role.
+	//
+	// DELETE /services/{id}
+	ServicesIDDelete(ctx context.Context, params ServicesIDDeleteParams) (ServicesIDDeleteRes, error)
+	// ServicesIDGet implements GET /services/{id} operation.
+	//
+	// Retrieve a specific catalog entry.
+	//
+	// GET /services/{id}
+	ServicesIDGet(ctx context.Context, params ServicesIDGetParams) (ServicesIDGetRes, error)
+	// ServicesIDPatch implements PATCH /services/{id} operation.
+	//
+	// Change a catalog entry, subscriptions referencing it take over a changed name. Requires the admin
+	// role.
+	//
+	// PATCH /services/{id}
+	ServicesIDPatch(ctx context.Context, req *ServicePatch, params ServicesIDPatchParams) (ServicesIDPatchRes, error)
+	// ServicesPost implements POST /services operation.
+	//
+	// Add a catalog entry, subscriptions to its name or aliases reference it. Requires the admin role.
+	//
+	// POST /services
+	ServicesPost(ctx context.Context, req *ServiceCreate) (ServicesPostRes, error)
 	// SubscriptionsGet implements GET /subscriptions operation.
 	//
 	// Retrieve server with optional filtering and pagination.
 	//
 	// GET /subscriptions
 	SubscriptionsGet(ctx context.Context, params SubscriptionsGetParams) (SubscriptionsGetRes, error)
+	// SubscriptionsIDCancelPost implements POST /subscriptions/{id}/cancel operation.
+	//
+	// End the subscription with the given month, the last month it is charged for.
+	//
+	// POST /subscriptions/{id}/cancel
+	SubscriptionsIDCancelPost(ctx context.Context, req *SubscriptionCancelRequest, params SubscriptionsIDCancelPostParams) (SubscriptionsIDCancelPostRes, error)
 	// SubscriptionsIDDelete implements DELETE /subscriptions/{id} operation.
 	//
 	// Delete a subscription record.
@@ -32,12 +99,24 @@ type Handler interface {
 	//
 	// PATCH /subscriptions/{id}
 	SubscriptionsIDPatch(ctx context.Context, req *SubscriptionPatch, params SubscriptionsIDPatchParams) (SubscriptionsIDPatchRes, error)
+	// SubscriptionsIDPausePost implements POST /subscriptions/{id}/pause operation.
+	//
+	// Pause a subscription for an interval of months, paused months are not charged.
+	//
+	// POST /subscriptions/{id}/pause
+	SubscriptionsIDPausePost(ctx context.Context, req *SubscriptionPauseRequest, params SubscriptionsIDPausePostParams) (SubscriptionsIDPausePostRes, error)
 	// SubscriptionsIDPut implements PUT /subscriptions/{id} operation.
 	//
 	// Fully update a subscription record.
 	//
 	// PUT /subscriptions/{id}
 	SubscriptionsIDPut(ctx context.Context, req *SubscriptionUpdate, params SubscriptionsIDPutParams) (SubscriptionsIDPutRes, error)
+	// SubscriptionsIDResumePost implements POST /subscriptions/{id}/resume operation.
+	//
+	// End the pause covering the given month, the subscription is charged again from that month.
+	//
+	// POST /subscriptions/{id}/resume
+	SubscriptionsIDResumePost(ctx context.Context, req *SubscriptionResumeRequest, params SubscriptionsIDResumePostParams) (SubscriptionsIDResumePostRes, error)
 	// SubscriptionsPost implements POST /subscriptions operation.
 	//
 	// Create a new subscription record for a user.
@@ -46,31 +125,40 @@ type Handler interface {
 	SubscriptionsPost(ctx context.Context, req *SubscriptionCreate) (SubscriptionsPostRes, error)
 	// SubscriptionsSummaryTotalCostGet implements GET /subscriptions/summary/total-cost operation.
 	//
-	// Calculate total cost of server for selected period with filtering.
+	// Calculate total cost of server for selected period with filtering, free trial and paused months
+	// are not charged and discounts are taken off.
 	//
 	// GET /subscriptions/summary/total-cost
 	SubscriptionsSummaryTotalCostGet(ctx context.Context, params SubscriptionsSummaryTotalCostGetParams) (SubscriptionsSummaryTotalCostGetRes, error)
-	// NewError creates *ErrorStatusCode from error returned by handler.
+	// SubscriptionsTrialsEndingGet implements GET /subscriptions/trials/ending operation.
+	//
+	// Retrieve subscriptions whose free trial ends within the next months, the current month included.
+	//
+	// GET /subscriptions/trials/ending
+	SubscriptionsTrialsEndingGet(ctx context.Context, params SubscriptionsTrialsEndingGetParams) (SubscriptionsTrialsEndingGetRes, error)
+	// NewError creates *ProblemStatusCode from error returned by handler.
 	//
 	// Used for common default response.
-	NewError(ctx context.Context, err error) *ErrorStatusCode
+	NewError(ctx context.Context, err error) *ProblemStatusCode
 }
 
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
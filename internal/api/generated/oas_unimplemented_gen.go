@@ -13,6 +13,97 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AdminAPIKeysGet implements GET /admin/api-keys operation.
+//
+// Retrieve all API keys without their secrets.
+//
+// GET /admin/api-keys
+func (UnimplementedHandler) AdminAPIKeysGet(ctx context.Context) (r *AdminAPIKeysGetOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminAPIKeysIDDelete implements DELETE /admin/api-keys/{id} operation.
+//
+// Revoke an API key, it can no longer be used for authentication.
+//
+// DELETE /admin/api-keys/{id}
+func (UnimplementedHandler) AdminAPIKeysIDDelete(ctx context.Context, params AdminAPIKeysIDDeleteParams) (r AdminAPIKeysIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminAPIKeysIDGet implements GET /admin/api-keys/{id} operation.
+//
+// Retrieve a specific API key without its secret.
+//
+// GET /admin/api-keys/{id}
+func (UnimplementedHandler) AdminAPIKeysIDGet(ctx context.Context, params AdminAPIKeysIDGetParams) (r AdminAPIKeysIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminAPIKeysIDPatch implements PATCH /admin/api-keys/{id} operation.
+//
+// Rename an API key or change its scopes.
+//
+// PATCH /admin/api-keys/{id}
+func (UnimplementedHandler) AdminAPIKeysIDPatch(ctx context.Context, req *APIKeyPatch, params AdminAPIKeysIDPatchParams) (r AdminAPIKeysIDPatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminAPIKeysPost implements POST /admin/api-keys operation.
+//
+// Issue a new API key for service-to-service access. The key is returned only once.
+//
+// POST /admin/api-keys
+func (UnimplementedHandler) AdminAPIKeysPost(ctx context.Context, req *APIKeyCreate) (r AdminAPIKeysPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ServicesGet implements GET /services operation.
+//
+// Retrieve all catalog entries ordered by name.
+//
+// GET /services
+func (UnimplementedHandler) ServicesGet(ctx context.Context, params ServicesGetParams) (r *ServicesGetOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ServicesIDDelete implements DELETE /services/{id} operation.
+//
+// Remove a catalog entry no subscription references. Requires the admin role.
+//
+// DELETE /services/{id}
+func (UnimplementedHandler) ServicesIDDelete(ctx context.Context, params ServicesIDDeleteParams) (r ServicesIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ServicesIDGet implements GET /services/{id} operation.
+//
+// Retrieve a specific catalog entry.
+//
+// GET /services/{id}
+func (UnimplementedHandler) ServicesIDGet(ctx context.Context, params ServicesIDGetParams) (r ServicesIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ServicesIDPatch implements PATCH /services/{id} operation.
+//
+// Change a catalog entry, subscriptions referencing it take over a changed name. Requires the admin
+// role.
+//
+// PATCH /services/{id}
+func (UnimplementedHandler) ServicesIDPatch(ctx context.Context, req *ServicePatch, params ServicesIDPatchParams) (r ServicesIDPatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ServicesPost implements POST /services operation.
+//
+// Add a catalog entry, subscriptions to its name or aliases reference it. Requires the admin role.
+//
+// POST /services
+func (UnimplementedHandler) ServicesPost(ctx context.Context, req *ServiceCreate) (r ServicesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SubscriptionsGet implements GET /subscriptions operation.
 //
 // Retrieve server with optional filtering and pagination.
@@ -22,6 +113,15 @@ func (UnimplementedHandler) SubscriptionsGet(ctx context.Context, params Subscri
 	return r, ht.ErrNotImplemented
 }
 
+// SubscriptionsIDCancelPost implements POST /subscriptions/{id}/cancel operation.
+//
+// End the subscription with the given month, the last month it is charged for.
+//
+// POST /subscriptions/{id}/cancel
+func (UnimplementedHandler) SubscriptionsIDCancelPost(ctx context.Context, req *SubscriptionCancelRequest, params SubscriptionsIDCancelPostParams) (r SubscriptionsIDCancelPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SubscriptionsIDDelete implements DELETE /subscriptions/{id} operation.
 //
 // Delete a subscription record.
@@ -49,6 +149,15 @@ func (UnimplementedHandler) SubscriptionsIDPatch(ctx context.Context, req *Subsc
 	return r, ht.ErrNotImplemented
 }
 
+// SubscriptionsIDPausePost implements POST /subscriptions/{id}/pause operation.
+//
+// Pause a subscription for an interval of months, paused months are not charged.
+//
+// POST /subscriptions/{id}/pause
+func (UnimplementedHandler) SubscriptionsIDPausePost(ctx context.Context, req *SubscriptionPauseRequest, params SubscriptionsIDPausePostParams) (r SubscriptionsIDPausePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SubscriptionsIDPut implements PUT /subscriptions/{id} operation.
 //
 // Fully update a subscription record.
@@ -58,6 +167,15 @@ func (UnimplementedHandler) SubscriptionsIDPut(ctx context.Context, req *Subscri
 	return r, ht.ErrNotImplemented
 }
 
+// SubscriptionsIDResumePost implements POST /subscriptions/{id}/resume operation.
+//
+// End the pause covering the given month, the subscription is charged again from that month.
+//
+// POST /subscriptions/{id}/resume
+func (UnimplementedHandler) SubscriptionsIDResumePost(ctx context.Context, req *SubscriptionResumeRequest, params SubscriptionsIDResumePostParams) (r SubscriptionsIDResumePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SubscriptionsPost implements POST /subscriptions operation.
 //
 // Create a new subscription record for a user.
@@ -69,17 +187,27 @@ func (UnimplementedHandler) SubscriptionsPost(ctx context.Context, req *Subscrip
 
 // SubscriptionsSummaryTotalCostGet implements GET /subscriptions/summary/total-cost operation.
 //
-// Calculate total cost of server for selected period with filtering.
+// Calculate total cost of server for selected period with filtering, free trial and paused months
+// are not charged and discounts are taken off.
 //
 // GET /subscriptions/summary/total-cost
 func (UnimplementedHandler) SubscriptionsSummaryTotalCostGet(ctx context.Context, params SubscriptionsSummaryTotalCostGetParams) (r SubscriptionsSummaryTotalCostGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// NewError creates *ErrorStatusCode from error returned by handler.
+// SubscriptionsTrialsEndingGet implements GET /subscriptions/trials/ending operation.
+//
+// Retrieve subscriptions whose free trial ends within the next months, the current month included.
+//
+// GET /subscriptions/trials/ending
+func (UnimplementedHandler) SubscriptionsTrialsEndingGet(ctx context.Context, params SubscriptionsTrialsEndingGetParams) (r SubscriptionsTrialsEndingGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NewError creates *ProblemStatusCode from error returned by handler.
 //
 // Used for common default response.
-func (UnimplementedHandler) NewError(ctx context.Context, err error) (r *ErrorStatusCode) {
-	r = new(ErrorStatusCode)
+func (UnimplementedHandler) NewError(ctx context.Context, err error) (r *ProblemStatusCode) {
+	r = new(ProblemStatusCode)
 	return r
 }
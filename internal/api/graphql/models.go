@@ -0,0 +1,8 @@
+package graphql
+
+import "github.com/google/uuid"
+
+// User identifies the user whose aggregates are resolved by the User field resolvers
+type User struct {
+	ID uuid.UUID `json:"id"`
+}
@@ -0,0 +1,16 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"subscription/core/domain"
+	"subscription/core/ports"
+)
+
+type Query struct {
+}
+
+type SubscriptionPage struct {
+	Items    []*domain.Subscription    `json:"items"`
+	PageInfo *ports.PaginationMetadata `json:"pageInfo"`
+}
@@ -0,0 +1,2421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: subscription/v1/subscription.proto
+
+// gRPC mirror of the subscription operations in api/openapi.yaml.
+// Dates use the MM-YYYY format of the REST API, identifiers are UUID strings.
+
+package subscriptionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Subscription struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	UserId      string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// MM-YYYY
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// MM-YYYY, unset while the subscription is open-ended
+	EndDate   *string                `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// MM-YYYY, last month of the free trial, trial months are not charged
+	TrialEndDate *string `protobuf:"bytes,9,opt,name=trial_end_date,json=trialEndDate,proto3,oneof" json:"trial_end_date,omitempty"`
+	// Ordered by start date, paused months are not charged
+	Pauses []*Pause `protobuf:"bytes,10,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	// Status in the current month: trial, active, paused, cancellation_scheduled or ended
+	Status string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	// Set once the subscription is cancelled
+	CancelledAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancellationReason *string                `protobuf:"bytes,13,opt,name=cancellation_reason,json=cancellationReason,proto3,oneof" json:"cancellation_reason,omitempty"`
+	// Catalog entry of the service, unset when the name is not in the catalog
+	ServiceId *string `protobuf:"bytes,14,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+	// Empty when unclassified
+	Category string `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
+	// Normalized to lower case
+	Tags []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// "equal", "percentage" or "fixed", empty when the subscription is not shared
+	Split string `protobuf:"bytes,17,opt,name=split,proto3" json:"split,omitempty"`
+	// Users sharing the price, the paying user bears the full price without members
+	Members []*Member `protobuf:"bytes,18,rep,name=members,proto3" json:"members,omitempty"`
+	// Ordered by start date, discounted months cost less
+	Discounts []*Discount `protobuf:"bytes,19,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Monthly price
+	Price *Money `protobuf:"bytes,20,opt,name=price,proto3" json:"price,omitempty"`
+	// VAT rate in basis points, 2000 is 20%
+	TaxRate int32 `protobuf:"varint,21,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	// Whether the price includes the tax, otherwise the tax is added on top of it
+	TaxInclusive  bool `protobuf:"varint,22,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Subscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Subscription) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetTrialEndDate() string {
+	if x != nil && x.TrialEndDate != nil {
+		return *x.TrialEndDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetPauses() []*Pause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetCancellationReason() string {
+	if x != nil && x.CancellationReason != nil {
+		return *x.CancellationReason
+	}
+	return ""
+}
+
+func (x *Subscription) GetServiceId() string {
+	if x != nil && x.ServiceId != nil {
+		return *x.ServiceId
+	}
+	return ""
+}
+
+func (x *Subscription) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Subscription) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Subscription) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *Subscription) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Subscription) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Subscription) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Subscription) GetTaxRate() int32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *Subscription) GetTaxInclusive() bool {
+	if x != nil {
+		return x.TaxInclusive
+	}
+	return false
+}
+
+// An exact amount, e.g. 399.00 rubles are 39900 RUB
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Minor units of the currency, e.g. kopecks or cents
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 currency code
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Member struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Percent for a percentage split, monthly amount in minor units of the price for a fixed split,
+	// 0 for an equal split
+	Share int64 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
+	// Monthly part of the price in its minor units the member bears, ignored in requests
+	Amount        int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Member) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Member) GetShare() int64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *Member) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Discount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "percentage" takes value percent off the price, "fixed" the amount value, at most down to 0
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Percent between 1 and 100 for "percentage", monthly amount in minor units of the price for "fixed"
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// MM-YYYY, first discounted month
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// MM-YYYY, last discounted month, unset while the discount lasts until the subscription ends
+	EndDate       *string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Discount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discount) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Discount) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Discount) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type Pause struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MM-YYYY, first paused month
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// MM-YYYY, last paused month, unset while paused until resumed
+	EndDate       *string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pause) Reset() {
+	*x = Pause{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pause) ProtoMessage() {}
+
+func (x *Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pause.ProtoReflect.Descriptor instead.
+func (*Pause) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Pause) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Pause) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type CreateSubscriptionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName  string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	UserId       string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate    string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	TrialEndDate *string                `protobuf:"bytes,6,opt,name=trial_end_date,json=trialEndDate,proto3,oneof" json:"trial_end_date,omitempty"`
+	// Defaults to the category of the catalog entry
+	Category string   `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Defaults to "equal" when members are given
+	Split   string    `protobuf:"bytes,9,opt,name=split,proto3" json:"split,omitempty"`
+	Members []*Member `protobuf:"bytes,10,rep,name=members,proto3" json:"members,omitempty"`
+	// Must not overlap each other
+	Discounts []*Discount `protobuf:"bytes,11,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Unset takes the default price of the catalog entry, an empty currency is RUB
+	Price *Money `protobuf:"bytes,12,opt,name=price,proto3" json:"price,omitempty"`
+	// VAT rate in basis points, 2000 is 20%
+	TaxRate int32 `protobuf:"varint,13,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	// Unset takes the price as tax-inclusive
+	TaxInclusive  *bool `protobuf:"varint,14,opt,name=tax_inclusive,json=taxInclusive,proto3,oneof" json:"tax_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateSubscriptionRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetTrialEndDate() string {
+	if x != nil && x.TrialEndDate != nil {
+		return *x.TrialEndDate
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetTaxRate() int32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionRequest) GetTaxInclusive() bool {
+	if x != nil && x.TaxInclusive != nil {
+		return *x.TaxInclusive
+	}
+	return false
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSubscriptionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserIds      []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ServiceNames []string               `protobuf:"bytes,2,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	// Start date lower bound, MM-YYYY
+	StartDateFrom *string `protobuf:"bytes,3,opt,name=start_date_from,json=startDateFrom,proto3,oneof" json:"start_date_from,omitempty"`
+	// Start date upper bound, MM-YYYY, requires start_date_from
+	StartDateTo *string `protobuf:"bytes,4,opt,name=start_date_to,json=startDateTo,proto3,oneof" json:"start_date_to,omitempty"`
+	// Defaults to 1
+	Page int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Defaults to 20, at most 100
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Statuses in the current month: trial, active, paused, cancellation_scheduled or ended
+	Statuses   []string `protobuf:"bytes,7,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Categories []string `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Subscriptions with any of the tags match
+	Tags          []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSubscriptionsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsRequest) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsRequest) GetStartDateFrom() string {
+	if x != nil && x.StartDateFrom != nil {
+		return *x.StartDateFrom
+	}
+	return ""
+}
+
+func (x *ListSubscriptionsRequest) GetStartDateTo() string {
+	if x != nil && x.StartDateTo != nil {
+		return *x.StartDateTo
+	}
+	return ""
+}
+
+func (x *ListSubscriptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSubscriptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSubscriptionsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int32                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Pagination) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Pagination) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Subscription        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSubscriptionsResponse) GetData() []*Subscription {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UpdateSubscriptionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName  string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	UserId       string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate    string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      *string                `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	TrialEndDate *string                `protobuf:"bytes,7,opt,name=trial_end_date,json=trialEndDate,proto3,oneof" json:"trial_end_date,omitempty"`
+	Category     string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags         []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Split        string                 `protobuf:"bytes,10,opt,name=split,proto3" json:"split,omitempty"`
+	Members      []*Member              `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	Discounts    []*Discount            `protobuf:"bytes,12,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Unset takes the default price of the catalog entry, an empty currency is RUB
+	Price *Money `protobuf:"bytes,13,opt,name=price,proto3" json:"price,omitempty"`
+	// VAT rate in basis points, 2000 is 20%
+	TaxRate int32 `protobuf:"varint,14,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	// Unset takes the price as tax-inclusive
+	TaxInclusive  *bool `protobuf:"varint,15,opt,name=tax_inclusive,json=taxInclusive,proto3,oneof" json:"tax_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetTrialEndDate() string {
+	if x != nil && x.TrialEndDate != nil {
+		return *x.TrialEndDate
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetTaxRate() int32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionRequest) GetTaxInclusive() bool {
+	if x != nil && x.TaxInclusive != nil {
+		return *x.TaxInclusive
+	}
+	return false
+}
+
+type PatchSubscriptionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName *string                `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`
+	EndDate     *string                `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// An empty string removes the trial
+	TrialEndDate *string `protobuf:"bytes,5,opt,name=trial_end_date,json=trialEndDate,proto3,oneof" json:"trial_end_date,omitempty"`
+	Category     *string `protobuf:"bytes,6,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// Replaces the tags when set, an empty list removes them
+	Tags  *TagList `protobuf:"bytes,7,opt,name=tags,proto3" json:"tags,omitempty"`
+	Split *string  `protobuf:"bytes,8,opt,name=split,proto3,oneof" json:"split,omitempty"`
+	// Replaces the members when set, an empty list ends the sharing
+	Members *MemberList `protobuf:"bytes,9,opt,name=members,proto3" json:"members,omitempty"`
+	// Replaces the discounts when set, an empty list removes them
+	Discounts *DiscountList `protobuf:"bytes,10,opt,name=discounts,proto3" json:"discounts,omitempty"`
+	// An empty currency keeps the current one
+	Price         *Money `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
+	TaxRate       *int32 `protobuf:"varint,12,opt,name=tax_rate,json=taxRate,proto3,oneof" json:"tax_rate,omitempty"`
+	TaxInclusive  *bool  `protobuf:"varint,13,opt,name=tax_inclusive,json=taxInclusive,proto3,oneof" json:"tax_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSubscriptionRequest) Reset() {
+	*x = PatchSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSubscriptionRequest) ProtoMessage() {}
+
+func (x *PatchSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PatchSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PatchSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetTrialEndDate() string {
+	if x != nil && x.TrialEndDate != nil {
+		return *x.TrialEndDate
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetTags() *TagList {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PatchSubscriptionRequest) GetSplit() string {
+	if x != nil && x.Split != nil {
+		return *x.Split
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionRequest) GetMembers() *MemberList {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PatchSubscriptionRequest) GetDiscounts() *DiscountList {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *PatchSubscriptionRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PatchSubscriptionRequest) GetTaxRate() int32 {
+	if x != nil && x.TaxRate != nil {
+		return *x.TaxRate
+	}
+	return 0
+}
+
+func (x *PatchSubscriptionRequest) GetTaxInclusive() bool {
+	if x != nil && x.TaxInclusive != nil {
+		return *x.TaxInclusive
+	}
+	return false
+}
+
+type TagList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagList) Reset() {
+	*x = TagList{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagList) ProtoMessage() {}
+
+func (x *TagList) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagList.ProtoReflect.Descriptor instead.
+func (*TagList) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TagList) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MemberList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberList) Reset() {
+	*x = MemberList{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberList) ProtoMessage() {}
+
+func (x *MemberList) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberList.ProtoReflect.Descriptor instead.
+func (*MemberList) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MemberList) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type DiscountList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*Discount            `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountList) Reset() {
+	*x = DiscountList{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountList) ProtoMessage() {}
+
+func (x *DiscountList) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountList.ProtoReflect.Descriptor instead.
+func (*DiscountList) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiscountList) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type PauseSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// MM-YYYY, first paused month, defaults to the current month
+	StartDate *string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// MM-YYYY, last paused month, the pause lasts until resumed when unset
+	EndDate       *string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PauseSubscriptionRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *PauseSubscriptionRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type ResumeSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// MM-YYYY, first month charged again, defaults to the current month
+	Date          *string `protobuf:"bytes,2,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResumeSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResumeSubscriptionRequest) GetDate() string {
+	if x != nil && x.Date != nil {
+		return *x.Date
+	}
+	return ""
+}
+
+type CancelSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// MM-YYYY, last charged month, defaults to the current month
+	EndDate       *string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Reason        *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ListEndingTrialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Months to look ahead, 1 selects trials ending in the current month. Defaults to 1, at most 12
+	WithinMonths int32    `protobuf:"varint,1,opt,name=within_months,json=withinMonths,proto3" json:"within_months,omitempty"`
+	UserIds      []string `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ServiceNames []string `protobuf:"bytes,3,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	// Defaults to 1
+	Page int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Defaults to 20, at most 100
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEndingTrialsRequest) Reset() {
+	*x = ListEndingTrialsRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEndingTrialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEndingTrialsRequest) ProtoMessage() {}
+
+func (x *ListEndingTrialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEndingTrialsRequest.ProtoReflect.Descriptor instead.
+func (*ListEndingTrialsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListEndingTrialsRequest) GetWithinMonths() int32 {
+	if x != nil {
+		return x.WithinMonths
+	}
+	return 0
+}
+
+func (x *ListEndingTrialsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListEndingTrialsRequest) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (x *ListEndingTrialsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEndingTrialsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTotalCostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MM-YYYY
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// MM-YYYY
+	EndDate      string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	UserIds      []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ServiceNames []string `protobuf:"bytes,4,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	Categories   []string `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Subscriptions with any of the tags are included
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// "category" or "tag" adds a breakdown of the total cost, a subscription counts under each of its tags
+	GroupBy *string `protobuf:"bytes,7,opt,name=group_by,json=groupBy,proto3,oneof" json:"group_by,omitempty"`
+	// "share" (default) attributes shared subscriptions to the members by their shares,
+	// "payer" charges the paying user the full price
+	Attribution *string `protobuf:"bytes,8,opt,name=attribution,proto3,oneof" json:"attribution,omitempty"`
+	// Only subscriptions priced in this ISO 4217 currency are added up, defaults to RUB
+	Currency      *string `protobuf:"bytes,9,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalCostRequest) Reset() {
+	*x = GetTotalCostRequest{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalCostRequest) ProtoMessage() {}
+
+func (x *GetTotalCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalCostRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalCostRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTotalCostRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetTotalCostRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetTotalCostRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetTotalCostRequest) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (x *GetTotalCostRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetTotalCostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetTotalCostRequest) GetGroupBy() string {
+	if x != nil && x.GroupBy != nil {
+		return *x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetTotalCostRequest) GetAttribution() string {
+	if x != nil && x.Attribution != nil {
+		return *x.Attribution
+	}
+	return ""
+}
+
+func (x *GetTotalCostRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+type Period struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Period) Reset() {
+	*x = Period{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Period) ProtoMessage() {}
+
+func (x *Period) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Period.ProtoReflect.Descriptor instead.
+func (*Period) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Period) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Period) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type FilterCriteria struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ServiceNames  []string               `protobuf:"bytes,2,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attribution   string                 `protobuf:"bytes,5,opt,name=attribution,proto3" json:"attribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterCriteria) Reset() {
+	*x = FilterCriteria{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCriteria) ProtoMessage() {}
+
+func (x *FilterCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCriteria.ProtoReflect.Descriptor instead.
+func (*FilterCriteria) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FilterCriteria) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *FilterCriteria) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (x *FilterCriteria) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *FilterCriteria) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FilterCriteria) GetAttribution() string {
+	if x != nil {
+		return x.Attribution
+	}
+	return ""
+}
+
+type GetTotalCostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Charged cost after discounts including tax in minor units of currency, net_cost + tax
+	TotalCost      int64           `protobuf:"varint,1,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	Period         *Period         `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	FilterCriteria *FilterCriteria `protobuf:"bytes,3,opt,name=filter_criteria,json=filterCriteria,proto3" json:"filter_criteria,omitempty"`
+	// Ordered by cost, only set when group_by is given
+	Breakdown []*CostBreakdownItem `protobuf:"bytes,4,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// Amount the discounts took off the cost in minor units of currency
+	Discount int64  `protobuf:"varint,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Charged cost after discounts without tax
+	NetCost int64 `protobuf:"varint,7,opt,name=net_cost,json=netCost,proto3" json:"net_cost,omitempty"`
+	// Tax contained in or added to the cost, rounded per subscription
+	Tax           int64 `protobuf:"varint,8,opt,name=tax,proto3" json:"tax,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalCostResponse) Reset() {
+	*x = GetTotalCostResponse{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalCostResponse) ProtoMessage() {}
+
+func (x *GetTotalCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalCostResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalCostResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTotalCostResponse) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetTotalCostResponse) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetTotalCostResponse) GetFilterCriteria() *FilterCriteria {
+	if x != nil {
+		return x.FilterCriteria
+	}
+	return nil
+}
+
+func (x *GetTotalCostResponse) GetBreakdown() []*CostBreakdownItem {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *GetTotalCostResponse) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *GetTotalCostResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetTotalCostResponse) GetNetCost() int64 {
+	if x != nil {
+		return x.NetCost
+	}
+	return 0
+}
+
+func (x *GetTotalCostResponse) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+type CostBreakdownItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Category or tag, empty for subscriptions without one
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TotalCost     int64  `protobuf:"varint,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	Discount      int64  `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	NetCost       int64  `protobuf:"varint,4,opt,name=net_cost,json=netCost,proto3" json:"net_cost,omitempty"`
+	Tax           int64  `protobuf:"varint,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostBreakdownItem) Reset() {
+	*x = CostBreakdownItem{}
+	mi := &file_subscription_v1_subscription_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostBreakdownItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostBreakdownItem) ProtoMessage() {}
+
+func (x *CostBreakdownItem) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_v1_subscription_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostBreakdownItem.ProtoReflect.Descriptor instead.
+func (*CostBreakdownItem) Descriptor() ([]byte, []int) {
+	return file_subscription_v1_subscription_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CostBreakdownItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CostBreakdownItem) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *CostBreakdownItem) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *CostBreakdownItem) GetNetCost() int64 {
+	if x != nil {
+		return x.NetCost
+	}
+	return 0
+}
+
+func (x *CostBreakdownItem) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+var File_subscription_v1_subscription_proto protoreflect.FileDescriptor
+
+const file_subscription_v1_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\"subscription/v1/subscription.proto\x12\x0fsubscription.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\a\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x00R\aendDate\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x0etrial_end_date\x18\t \x01(\tH\x01R\ftrialEndDate\x88\x01\x01\x12.\n" +
+	"\x06pauses\x18\n" +
+	" \x03(\v2\x16.subscription.v1.PauseR\x06pauses\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12=\n" +
+	"\fcancelled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x124\n" +
+	"\x13cancellation_reason\x18\r \x01(\tH\x02R\x12cancellationReason\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"service_id\x18\x0e \x01(\tH\x03R\tserviceId\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\x0f \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05split\x18\x11 \x01(\tR\x05split\x121\n" +
+	"\amembers\x18\x12 \x03(\v2\x17.subscription.v1.MemberR\amembers\x127\n" +
+	"\tdiscounts\x18\x13 \x03(\v2\x19.subscription.v1.DiscountR\tdiscounts\x12,\n" +
+	"\x05price\x18\x14 \x01(\v2\x16.subscription.v1.MoneyR\x05price\x12\x19\n" +
+	"\btax_rate\x18\x15 \x01(\x05R\ataxRate\x12#\n" +
+	"\rtax_inclusive\x18\x16 \x01(\bR\ftaxInclusiveB\v\n" +
+	"\t_end_dateB\x11\n" +
+	"\x0f_trial_end_dateB\x16\n" +
+	"\x14_cancellation_reasonB\r\n" +
+	"\v_service_idJ\x04\b\x03\x10\x04\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"O\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05share\x18\x02 \x01(\x03R\x05share\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\x80\x01\n" +
+	"\bDiscount\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x00R\aendDate\x88\x01\x01B\v\n" +
+	"\t_end_date\"S\n" +
+	"\x05Pause\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tH\x00R\aendDate\x88\x01\x01B\v\n" +
+	"\t_end_date\"\x9e\x04\n" +
+	"\x19CreateSubscriptionRequest\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x05 \x01(\tH\x00R\aendDate\x88\x01\x01\x12)\n" +
+	"\x0etrial_end_date\x18\x06 \x01(\tH\x01R\ftrialEndDate\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x14\n" +
+	"\x05split\x18\t \x01(\tR\x05split\x121\n" +
+	"\amembers\x18\n" +
+	" \x03(\v2\x17.subscription.v1.MemberR\amembers\x127\n" +
+	"\tdiscounts\x18\v \x03(\v2\x19.subscription.v1.DiscountR\tdiscounts\x12,\n" +
+	"\x05price\x18\f \x01(\v2\x16.subscription.v1.MoneyR\x05price\x12\x19\n" +
+	"\btax_rate\x18\r \x01(\x05R\ataxRate\x12(\n" +
+	"\rtax_inclusive\x18\x0e \x01(\bH\x02R\ftaxInclusive\x88\x01\x01B\v\n" +
+	"\t_end_dateB\x11\n" +
+	"\x0f_trial_end_dateB\x10\n" +
+	"\x0e_tax_inclusiveJ\x04\b\x02\x10\x03\"(\n" +
+	"\x16GetSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd0\x02\n" +
+	"\x18ListSubscriptionsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12#\n" +
+	"\rservice_names\x18\x02 \x03(\tR\fserviceNames\x12+\n" +
+	"\x0fstart_date_from\x18\x03 \x01(\tH\x00R\rstartDateFrom\x88\x01\x01\x12'\n" +
+	"\rstart_date_to\x18\x04 \x01(\tH\x01R\vstartDateTo\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bstatuses\x18\a \x03(\tR\bstatuses\x12\x1e\n" +
+	"\n" +
+	"categories\x18\b \x03(\tR\n" +
+	"categories\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tagsB\x12\n" +
+	"\x10_start_date_fromB\x10\n" +
+	"\x0e_start_date_to\"b\n" +
+	"\n" +
+	"Pagination\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x05R\x05pages\"\x8b\x01\n" +
+	"\x19ListSubscriptionsResponse\x121\n" +
+	"\x04data\x18\x01 \x03(\v2\x1d.subscription.v1.SubscriptionR\x04data\x12;\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1b.subscription.v1.PaginationR\n" +
+	"pagination\"\xae\x04\n" +
+	"\x19UpdateSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x00R\aendDate\x88\x01\x01\x12)\n" +
+	"\x0etrial_end_date\x18\a \x01(\tH\x01R\ftrialEndDate\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x14\n" +
+	"\x05split\x18\n" +
+	" \x01(\tR\x05split\x121\n" +
+	"\amembers\x18\v \x03(\v2\x17.subscription.v1.MemberR\amembers\x127\n" +
+	"\tdiscounts\x18\f \x03(\v2\x19.subscription.v1.DiscountR\tdiscounts\x12,\n" +
+	"\x05price\x18\r \x01(\v2\x16.subscription.v1.MoneyR\x05price\x12\x19\n" +
+	"\btax_rate\x18\x0e \x01(\x05R\ataxRate\x12(\n" +
+	"\rtax_inclusive\x18\x0f \x01(\bH\x02R\ftaxInclusive\x88\x01\x01B\v\n" +
+	"\t_end_dateB\x11\n" +
+	"\x0f_trial_end_dateB\x10\n" +
+	"\x0e_tax_inclusiveJ\x04\b\x03\x10\x04\"\xe0\x04\n" +
+	"\x18PatchSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\fservice_name\x18\x02 \x01(\tH\x00R\vserviceName\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x01R\aendDate\x88\x01\x01\x12)\n" +
+	"\x0etrial_end_date\x18\x05 \x01(\tH\x02R\ftrialEndDate\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x06 \x01(\tH\x03R\bcategory\x88\x01\x01\x12,\n" +
+	"\x04tags\x18\a \x01(\v2\x18.subscription.v1.TagListR\x04tags\x12\x19\n" +
+	"\x05split\x18\b \x01(\tH\x04R\x05split\x88\x01\x01\x125\n" +
+	"\amembers\x18\t \x01(\v2\x1b.subscription.v1.MemberListR\amembers\x12;\n" +
+	"\tdiscounts\x18\n" +
+	" \x01(\v2\x1d.subscription.v1.DiscountListR\tdiscounts\x12,\n" +
+	"\x05price\x18\v \x01(\v2\x16.subscription.v1.MoneyR\x05price\x12\x1e\n" +
+	"\btax_rate\x18\f \x01(\x05H\x05R\ataxRate\x88\x01\x01\x12(\n" +
+	"\rtax_inclusive\x18\r \x01(\bH\x06R\ftaxInclusive\x88\x01\x01B\x0f\n" +
+	"\r_service_nameB\v\n" +
+	"\t_end_dateB\x11\n" +
+	"\x0f_trial_end_dateB\v\n" +
+	"\t_categoryB\b\n" +
+	"\x06_splitB\v\n" +
+	"\t_tax_rateB\x10\n" +
+	"\x0e_tax_inclusiveJ\x04\b\x03\x10\x04\"\x1d\n" +
+	"\aTagList\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"?\n" +
+	"\n" +
+	"MemberList\x121\n" +
+	"\amembers\x18\x01 \x03(\v2\x17.subscription.v1.MemberR\amembers\"G\n" +
+	"\fDiscountList\x127\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x19.subscription.v1.DiscountR\tdiscounts\"\x8a\x01\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x01R\aendDate\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"M\n" +
+	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04date\x18\x02 \x01(\tH\x00R\x04date\x88\x01\x01B\a\n" +
+	"\x05_date\"\x80\x01\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tH\x00R\aendDate\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x01R\x06reason\x88\x01\x01B\v\n" +
+	"\t_end_dateB\t\n" +
+	"\a_reason\"\xa8\x01\n" +
+	"\x17ListEndingTrialsRequest\x12#\n" +
+	"\rwithin_months\x18\x01 \x01(\x05R\fwithinMonths\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12#\n" +
+	"\rservice_names\x18\x03 \x03(\tR\fserviceNames\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"+\n" +
+	"\x19DeleteSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd5\x02\n" +
+	"\x13GetTotalCostRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\tR\auserIds\x12#\n" +
+	"\rservice_names\x18\x04 \x03(\tR\fserviceNames\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\tR\n" +
+	"categories\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1e\n" +
+	"\bgroup_by\x18\a \x01(\tH\x00R\agroupBy\x88\x01\x01\x12%\n" +
+	"\vattribution\x18\b \x01(\tH\x01R\vattribution\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\t \x01(\tH\x02R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_group_byB\x0e\n" +
+	"\f_attributionB\v\n" +
+	"\t_currency\"B\n" +
+	"\x06Period\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xa6\x01\n" +
+	"\x0eFilterCriteria\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12#\n" +
+	"\rservice_names\x18\x02 \x03(\tR\fserviceNames\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12 \n" +
+	"\vattribution\x18\x05 \x01(\tR\vattribution\"\xd7\x02\n" +
+	"\x14GetTotalCostResponse\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x01 \x01(\x03R\ttotalCost\x12/\n" +
+	"\x06period\x18\x02 \x01(\v2\x17.subscription.v1.PeriodR\x06period\x12H\n" +
+	"\x0ffilter_criteria\x18\x03 \x01(\v2\x1f.subscription.v1.FilterCriteriaR\x0efilterCriteria\x12@\n" +
+	"\tbreakdown\x18\x04 \x03(\v2\".subscription.v1.CostBreakdownItemR\tbreakdown\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x03R\bdiscount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x19\n" +
+	"\bnet_cost\x18\a \x01(\x03R\anetCost\x12\x10\n" +
+	"\x03tax\x18\b \x01(\x03R\x03tax\"\x8d\x01\n" +
+	"\x11CostBreakdownItem\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x02 \x01(\x03R\ttotalCost\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x03R\bdiscount\x12\x19\n" +
+	"\bnet_cost\x18\x04 \x01(\x03R\anetCost\x12\x10\n" +
+	"\x03tax\x18\x05 \x01(\x03R\x03tax2\xbf\b\n" +
+	"\x13SubscriptionService\x12_\n" +
+	"\x12CreateSubscription\x12*.subscription.v1.CreateSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12Y\n" +
+	"\x0fGetSubscription\x12'.subscription.v1.GetSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12j\n" +
+	"\x11ListSubscriptions\x12).subscription.v1.ListSubscriptionsRequest\x1a*.subscription.v1.ListSubscriptionsResponse\x12_\n" +
+	"\x12UpdateSubscription\x12*.subscription.v1.UpdateSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12]\n" +
+	"\x11PatchSubscription\x12).subscription.v1.PatchSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12X\n" +
+	"\x12DeleteSubscription\x12*.subscription.v1.DeleteSubscriptionRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x11PauseSubscription\x12).subscription.v1.PauseSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12_\n" +
+	"\x12ResumeSubscription\x12*.subscription.v1.ResumeSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12_\n" +
+	"\x12CancelSubscription\x12*.subscription.v1.CancelSubscriptionRequest\x1a\x1d.subscription.v1.Subscription\x12h\n" +
+	"\x10ListEndingTrials\x12(.subscription.v1.ListEndingTrialsRequest\x1a*.subscription.v1.ListSubscriptionsResponse\x12[\n" +
+	"\fGetTotalCost\x12$.subscription.v1.GetTotalCostRequest\x1a%.subscription.v1.GetTotalCostResponseB?Z=subscription/internal/api/grpc/subscription/v1;subscriptionv1b\x06proto3"
+
+var (
+	file_subscription_v1_subscription_proto_rawDescOnce sync.Once
+	file_subscription_v1_subscription_proto_rawDescData []byte
+)
+
+func file_subscription_v1_subscription_proto_rawDescGZIP() []byte {
+	file_subscription_v1_subscription_proto_rawDescOnce.Do(func() {
+		file_subscription_v1_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subscription_v1_subscription_proto_rawDesc), len(file_subscription_v1_subscription_proto_rawDesc)))
+	})
+	return file_subscription_v1_subscription_proto_rawDescData
+}
+
+var file_subscription_v1_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_subscription_v1_subscription_proto_goTypes = []any{
+	(*Subscription)(nil),              // 0: subscription.v1.Subscription
+	(*Money)(nil),                     // 1: subscription.v1.Money
+	(*Member)(nil),                    // 2: subscription.v1.Member
+	(*Discount)(nil),                  // 3: subscription.v1.Discount
+	(*Pause)(nil),                     // 4: subscription.v1.Pause
+	(*CreateSubscriptionRequest)(nil), // 5: subscription.v1.CreateSubscriptionRequest
+	(*GetSubscriptionRequest)(nil),    // 6: subscription.v1.GetSubscriptionRequest
+	(*ListSubscriptionsRequest)(nil),  // 7: subscription.v1.ListSubscriptionsRequest
+	(*Pagination)(nil),                // 8: subscription.v1.Pagination
+	(*ListSubscriptionsResponse)(nil), // 9: subscription.v1.ListSubscriptionsResponse
+	(*UpdateSubscriptionRequest)(nil), // 10: subscription.v1.UpdateSubscriptionRequest
+	(*PatchSubscriptionRequest)(nil),  // 11: subscription.v1.PatchSubscriptionRequest
+	(*TagList)(nil),                   // 12: subscription.v1.TagList
+	(*MemberList)(nil),                // 13: subscription.v1.MemberList
+	(*DiscountList)(nil),              // 14: subscription.v1.DiscountList
+	(*PauseSubscriptionRequest)(nil),  // 15: subscription.v1.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil), // 16: subscription.v1.ResumeSubscriptionRequest
+	(*CancelSubscriptionRequest)(nil), // 17: subscription.v1.CancelSubscriptionRequest
+	(*ListEndingTrialsRequest)(nil),   // 18: subscription.v1.ListEndingTrialsRequest
+	(*DeleteSubscriptionRequest)(nil), // 19: subscription.v1.DeleteSubscriptionRequest
+	(*GetTotalCostRequest)(nil),       // 20: subscription.v1.GetTotalCostRequest
+	(*Period)(nil),                    // 21: subscription.v1.Period
+	(*FilterCriteria)(nil),            // 22: subscription.v1.FilterCriteria
+	(*GetTotalCostResponse)(nil),      // 23: subscription.v1.GetTotalCostResponse
+	(*CostBreakdownItem)(nil),         // 24: subscription.v1.CostBreakdownItem
+	(*timestamppb.Timestamp)(nil),     // 25: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 26: google.protobuf.Empty
+}
+var file_subscription_v1_subscription_proto_depIdxs = []int32{
+	25, // 0: subscription.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	25, // 1: subscription.v1.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: subscription.v1.Subscription.pauses:type_name -> subscription.v1.Pause
+	25, // 3: subscription.v1.Subscription.cancelled_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: subscription.v1.Subscription.members:type_name -> subscription.v1.Member
+	3,  // 5: subscription.v1.Subscription.discounts:type_name -> subscription.v1.Discount
+	1,  // 6: subscription.v1.Subscription.price:type_name -> subscription.v1.Money
+	2,  // 7: subscription.v1.CreateSubscriptionRequest.members:type_name -> subscription.v1.Member
+	3,  // 8: subscription.v1.CreateSubscriptionRequest.discounts:type_name -> subscription.v1.Discount
+	1,  // 9: subscription.v1.CreateSubscriptionRequest.price:type_name -> subscription.v1.Money
+	0,  // 10: subscription.v1.ListSubscriptionsResponse.data:type_name -> subscription.v1.Subscription
+	8,  // 11: subscription.v1.ListSubscriptionsResponse.pagination:type_name -> subscription.v1.Pagination
+	2,  // 12: subscription.v1.UpdateSubscriptionRequest.members:type_name -> subscription.v1.Member
+	3,  // 13: subscription.v1.UpdateSubscriptionRequest.discounts:type_name -> subscription.v1.Discount
+	1,  // 14: subscription.v1.UpdateSubscriptionRequest.price:type_name -> subscription.v1.Money
+	12, // 15: subscription.v1.PatchSubscriptionRequest.tags:type_name -> subscription.v1.TagList
+	13, // 16: subscription.v1.PatchSubscriptionRequest.members:type_name -> subscription.v1.MemberList
+	14, // 17: subscription.v1.PatchSubscriptionRequest.discounts:type_name -> subscription.v1.DiscountList
+	1,  // 18: subscription.v1.PatchSubscriptionRequest.price:type_name -> subscription.v1.Money
+	2,  // 19: subscription.v1.MemberList.members:type_name -> subscription.v1.Member
+	3,  // 20: subscription.v1.DiscountList.discounts:type_name -> subscription.v1.Discount
+	21, // 21: subscription.v1.GetTotalCostResponse.period:type_name -> subscription.v1.Period
+	22, // 22: subscription.v1.GetTotalCostResponse.filter_criteria:type_name -> subscription.v1.FilterCriteria
+	24, // 23: subscription.v1.GetTotalCostResponse.breakdown:type_name -> subscription.v1.CostBreakdownItem
+	5,  // 24: subscription.v1.SubscriptionService.CreateSubscription:input_type -> subscription.v1.CreateSubscriptionRequest
+	6,  // 25: subscription.v1.SubscriptionService.GetSubscription:input_type -> subscription.v1.GetSubscriptionRequest
+	7,  // 26: subscription.v1.SubscriptionService.ListSubscriptions:input_type -> subscription.v1.ListSubscriptionsRequest
+	10, // 27: subscription.v1.SubscriptionService.UpdateSubscription:input_type -> subscription.v1.UpdateSubscriptionRequest
+	11, // 28: subscription.v1.SubscriptionService.PatchSubscription:input_type -> subscription.v1.PatchSubscriptionRequest
+	19, // 29: subscription.v1.SubscriptionService.DeleteSubscription:input_type -> subscription.v1.DeleteSubscriptionRequest
+	15, // 30: subscription.v1.SubscriptionService.PauseSubscription:input_type -> subscription.v1.PauseSubscriptionRequest
+	16, // 31: subscription.v1.SubscriptionService.ResumeSubscription:input_type -> subscription.v1.ResumeSubscriptionRequest
+	17, // 32: subscription.v1.SubscriptionService.CancelSubscription:input_type -> subscription.v1.CancelSubscriptionRequest
+	18, // 33: subscription.v1.SubscriptionService.ListEndingTrials:input_type -> subscription.v1.ListEndingTrialsRequest
+	20, // 34: subscription.v1.SubscriptionService.GetTotalCost:input_type -> subscription.v1.GetTotalCostRequest
+	0,  // 35: subscription.v1.SubscriptionService.CreateSubscription:output_type -> subscription.v1.Subscription
+	0,  // 36: subscription.v1.SubscriptionService.GetSubscription:output_type -> subscription.v1.Subscription
+	9,  // 37: subscription.v1.SubscriptionService.ListSubscriptions:output_type -> subscription.v1.ListSubscriptionsResponse
+	0,  // 38: subscription.v1.SubscriptionService.UpdateSubscription:output_type -> subscription.v1.Subscription
+	0,  // 39: subscription.v1.SubscriptionService.PatchSubscription:output_type -> subscription.v1.Subscription
+	26, // 40: subscription.v1.SubscriptionService.DeleteSubscription:output_type -> google.protobuf.Empty
+	0,  // 41: subscription.v1.SubscriptionService.PauseSubscription:output_type -> subscription.v1.Subscription
+	0,  // 42: subscription.v1.SubscriptionService.ResumeSubscription:output_type -> subscription.v1.Subscription
+	0,  // 43: subscription.v1.SubscriptionService.CancelSubscription:output_type -> subscription.v1.Subscription
+	9,  // 44: subscription.v1.SubscriptionService.ListEndingTrials:output_type -> subscription.v1.ListSubscriptionsResponse
+	23, // 45: subscription.v1.SubscriptionService.GetTotalCost:output_type -> subscription.v1.GetTotalCostResponse
+	35, // [35:46] is the sub-list for method output_type
+	24, // [24:35] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_subscription_v1_subscription_proto_init() }
+func file_subscription_v1_subscription_proto_init() {
+	if File_subscription_v1_subscription_proto != nil {
+		return
+	}
+	file_subscription_v1_subscription_proto_msgTypes[0].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[3].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[4].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[5].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[7].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[10].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[11].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[15].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[16].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[17].OneofWrappers = []any{}
+	file_subscription_v1_subscription_proto_msgTypes[20].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_v1_subscription_proto_rawDesc), len(file_subscription_v1_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_subscription_v1_subscription_proto_goTypes,
+		DependencyIndexes: file_subscription_v1_subscription_proto_depIdxs,
+		MessageInfos:      file_subscription_v1_subscription_proto_msgTypes,
+	}.Build()
+	File_subscription_v1_subscription_proto = out.File
+	file_subscription_v1_subscription_proto_goTypes = nil
+	file_subscription_v1_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"subscription/core/ports"
+)
+
+// APIKeyHeader carries API keys for service-to-service access
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator resolves API keys into principals
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, rawKey string) (*ports.Principal, error)
+}
+
+// Authenticator authenticates requests with either a bearer token or an API key
+type Authenticator struct {
+	tokens  *Validator
+	apiKeys APIKeyAuthenticator
+}
+
+func NewAuthenticator(tokens *Validator, apiKeys APIKeyAuthenticator) *Authenticator {
+	return &Authenticator{tokens: tokens, apiKeys: apiKeys}
+}
+
+// AuthenticateRequest authenticates the request by its X-API-Key or Authorization header
+func (a *Authenticator) AuthenticateRequest(r *http.Request) (*ports.Principal, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return a.AuthenticateAPIKey(r.Context(), key)
+	}
+
+	return a.tokens.ValidateAuthorizationHeader(r.Context(), r.Header.Get("Authorization"))
+}
+
+// AuthenticateToken validates a raw bearer token
+func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*ports.Principal, error) {
+	return a.tokens.Validate(ctx, token)
+}
+
+// AuthenticateAPIKey validates a plaintext API key
+func (a *Authenticator) AuthenticateAPIKey(ctx context.Context, key string) (*ports.Principal, error) {
+	return a.apiKeys.Authenticate(ctx, key)
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"subscription/internal/logger"
+)
+
+// minJWKSRefreshInterval limits refreshes triggered by unknown key IDs
+const minJWKSRefreshInterval = time.Minute
+
+// jwk is a single JSON Web Key (RFC 7517). Only RSA keys are supported.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+// keySet holds RSA public keys loaded from a JWKS file or URL
+type keySet struct {
+	mu          sync.RWMutex
+	keys        map[string]*rsa.PublicKey
+	file        string
+	url         string
+	httpClient  *http.Client
+	refreshedAt time.Time
+	refreshTTL  time.Duration
+}
+
+func newKeySet(file, url string, refreshTTL time.Duration) *keySet {
+	return &keySet{
+		keys:       make(map[string]*rsa.PublicKey),
+		file:       file,
+		url:        url,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		refreshTTL: refreshTTL,
+	}
+}
+
+// get returns the key with the given ID, reloading the set when the key is unknown
+// or the cached set is older than refreshTTL
+func (ks *keySet) get(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	age := time.Since(ks.refreshedAt)
+	ks.mu.RUnlock()
+
+	if ok && (ks.refreshTTL <= 0 || age < ks.refreshTTL) {
+		return key, nil
+	}
+
+	if ok || age >= minJWKSRefreshInterval {
+		if err := ks.refresh(ctx); err != nil {
+			if ok {
+				// Keep serving the cached key when the key source is temporarily unavailable
+				logger.Warn().Err(err).Msg("Failed to refresh JWKS, using cached keys")
+				return key, nil
+			}
+			return nil, err
+		}
+	}
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if key, ok = ks.keys[kid]; !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+// refresh reloads the key set from its source
+func (ks *keySet) refresh(ctx context.Context) error {
+	data, err := ks.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.refreshedAt = time.Now()
+	ks.mu.Unlock()
+
+	logger.Debug().Int("keys", len(keys)).Msg("JWKS loaded")
+	return nil
+}
+
+func (ks *keySet) load(ctx context.Context) ([]byte, error) {
+	if ks.file != "" {
+		data, err := os.ReadFile(ks.file)
+		if err != nil {
+			return nil, fmt.Errorf("reading JWKS file: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating JWKS request: %w", err)
+	}
+
+	resp, err := ks.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS response: %w", err)
+	}
+	return data, nil
+}
+
+// parseJWKS parses RSA signing keys from a JWKS document
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set jwkSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("decoding JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		key, err := rsaPublicKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no RSA signing keys")
+	}
+	return keys, nil
+}
+
+func rsaPublicKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("decoding modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("decoding exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"subscription/core/ports"
+)
+
+const (
+	DefaultRolesClaim          = "roles"
+	DefaultTenantClaim         = "tenant_id"
+	DefaultJWKSRefreshInterval = 15 * time.Minute
+	defaultLeeway              = 30 * time.Second
+)
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Config holds settings for JWT validation
+type Config struct {
+	HS256Secret         string
+	JWKSFile            string
+	JWKSURL             string
+	Issuer              string
+	Audience            string
+	RolesClaim          string
+	TenantClaim         string
+	JWKSRefreshInterval time.Duration
+}
+
+// Validator validates JWT bearer tokens and maps their claims to a principal
+type Validator struct {
+	secret      []byte
+	keys        *keySet
+	parser      *jwt.Parser
+	rolesClaim  string
+	tenantClaim string
+}
+
+// NewValidator creates a validator for HS256 and/or RS256 (JWKS) signed tokens
+func NewValidator(cfg Config) (*Validator, error) {
+	if cfg.HS256Secret == "" && cfg.JWKSFile == "" && cfg.JWKSURL == "" {
+		return nil, errors.New("no JWT key configured: set an HS256 secret or a JWKS file/URL")
+	}
+	if cfg.JWKSFile != "" && cfg.JWKSURL != "" {
+		return nil, errors.New("JWKS file and JWKS URL are mutually exclusive")
+	}
+
+	v := &Validator{rolesClaim: cfg.RolesClaim, tenantClaim: cfg.TenantClaim}
+	if v.rolesClaim == "" {
+		v.rolesClaim = DefaultRolesClaim
+	}
+	if v.tenantClaim == "" {
+		v.tenantClaim = DefaultTenantClaim
+	}
+
+	var methods []string
+	if cfg.HS256Secret != "" {
+		v.secret = []byte(cfg.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	if cfg.JWKSFile != "" || cfg.JWKSURL != "" {
+		refresh := cfg.JWKSRefreshInterval
+		if refresh == 0 {
+			refresh = DefaultJWKSRefreshInterval
+		}
+		v.keys = newKeySet(cfg.JWKSFile, cfg.JWKSURL, refresh)
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+
+		if err := v.keys.refresh(context.Background()); err != nil {
+			return nil, fmt.Errorf("loading JWKS: %w", err)
+		}
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(defaultLeeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// ValidateAuthorizationHeader validates the value of an Authorization header
+func (v *Validator) ValidateAuthorizationHeader(ctx context.Context, header string) (*ports.Principal, error) {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return nil, ErrMissingToken
+	}
+	return v.Validate(ctx, strings.TrimSpace(token))
+}
+
+// Validate parses and verifies the token and returns the principal it describes
+func (v *Validator) Validate(ctx context.Context, tokenString string) (*ports.Principal, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := v.parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return v.key(ctx, token)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, fmt.Errorf("%w: subject claim is required", ErrInvalidToken)
+	}
+
+	// Every principal belongs to exactly one tenant
+	tenant, _ := claims[v.tenantClaim].(string)
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil || tenantID == uuid.Nil {
+		return nil, fmt.Errorf("%w: %s claim must be a UUID", ErrInvalidToken, v.tenantClaim)
+	}
+
+	issuer, _ := claims.GetIssuer()
+
+	return &ports.Principal{
+		Kind:     ports.PrincipalUser,
+		Subject:  subject,
+		Issuer:   issuer,
+		Roles:    stringListClaim(claims[v.rolesClaim]),
+		TenantID: tenantID,
+	}, nil
+}
+
+// key selects the verification key for the token's signing method
+func (v *Validator) key(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if v.secret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return v.secret, nil
+	case jwt.SigningMethodRS256.Alg():
+		if v.keys == nil {
+			return nil, errors.New("RS256 tokens are not accepted")
+		}
+		kid, _ := token.Header["kid"].(string)
+		return v.keys.get(ctx, kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+// stringListClaim accepts both JSON arrays and space-separated strings
+func stringListClaim(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
@@ -1,55 +1,330 @@
 package config
 
 import (
-	"github.com/joho/godotenv"
+	"errors"
+	"fmt"
 	"os"
-	"subscription/internal/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog/log"
 )
 
 const (
-	DefaultLogLevel = "info"
-	DefaultSSLMode  = "disable"
-)
+	DefaultLogLevel  = "info"
+	DefaultLogFormat = "console"
+	DefaultSSLMode   = "disable"
+
+	DefaultServerReadTimeout     = 15 * time.Second
+	DefaultServerWriteTimeout    = 15 * time.Second
+	DefaultServerIdleTimeout     = 60 * time.Second
+	DefaultServerShutdownTimeout = 30 * time.Second
+
+	DefaultGRPCEnabled = true
+	DefaultGRPCPort    = "9090"
+
+	DefaultGraphQLEnabled       = true
+	DefaultGraphQLMaxDepth      = 10
+	DefaultGraphQLMaxComplexity = 1000
+
+	DefaultEventsPollInterval      = time.Second
+	DefaultEventsHeartbeatInterval = 15 * time.Second
+	DefaultEventsRetention         = 7 * 24 * time.Hour
+	DefaultEventsCleanupInterval   = time.Hour
+
+	DefaultCatalogMode = "normalize"
 
-var (
-	LogLevel string
+	DefaultDBMaxOpenConns    = 25
+	DefaultDBMaxIdleConns    = 5
+	DefaultDBConnMaxLifetime = 30 * time.Minute
+	DefaultDBConnMaxIdleTime = 10 * time.Minute
 
-	ServerPort string
-	ServerHost string
+	DefaultIdempotencyTTL             = 24 * time.Hour
+	DefaultIdempotencyCleanupInterval = time.Hour
 
-	DBHost     string
-	DBPort     string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	SSLMode    string
+	DefaultJWTRolesClaim          = "roles"
+	DefaultJWTTenantClaim         = "tenant_id"
+	DefaultJWTJWKSRefreshInterval = 15 * time.Minute
+
+	DefaultRateLimitRPS        = 10
+	DefaultRateLimitBurst      = 20
+	DefaultRateLimitIPRPS      = 50
+	DefaultRateLimitIPBurst    = 100
+	DefaultRateLimitMaxClients = 10000
+
+	DefaultMetricsRefreshInterval = time.Minute
+	DefaultMetricsPort            = "9091"
+
+	DefaultTracingExporter    = "none"
+	DefaultTracingServiceName = "subscription-api"
+	DefaultTracingSampleRatio = 1.0
 )
 
-// Load initializes the application's configuration by loading environment variables.
-// It attempts to load variables from a .env.local file and then overrides them with
-// environment variables if available. Missing required variables will cause the
-// application to panic.
-func Load() error {
-	// Attempt to load variables from a .env.local file
+// Config is the application configuration
+type Config struct {
+	Log         LogConfig         `yaml:"log" toml:"log"`
+	Server      ServerConfig      `yaml:"server" toml:"server"`
+	GRPC        GRPCConfig        `yaml:"grpc" toml:"grpc"`
+	GraphQL     GraphQLConfig     `yaml:"graphql" toml:"graphql"`
+	Events      EventsConfig      `yaml:"events" toml:"events"`
+	Catalog     CatalogConfig     `yaml:"catalog" toml:"catalog"`
+	Database    DatabaseConfig    `yaml:"database" toml:"database"`
+	JWT         JWTConfig         `yaml:"jwt" toml:"jwt"`
+	Idempotency IdempotencyConfig `yaml:"idempotency" toml:"idempotency"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit" toml:"rate_limit"`
+	Metrics     MetricsConfig     `yaml:"metrics" toml:"metrics"`
+	Tracing     TracingConfig     `yaml:"tracing" toml:"tracing"`
+}
+
+// LogConfig configures the application logger
+type LogConfig struct {
+	Level  string `yaml:"level" toml:"level"`
+	Format string `yaml:"format" toml:"format"` // "console" or "json"
+}
+
+// ServerConfig configures the HTTP server
+type ServerConfig struct {
+	Host            string        `yaml:"host" toml:"host"`
+	Port            string        `yaml:"port" toml:"port"`
+	ReadTimeout     time.Duration `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout    time.Duration `yaml:"write_timeout" toml:"write_timeout"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout" toml:"idle_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+}
+
+// Addr returns the listen address of the server
+func (c ServerConfig) Addr() string {
+	return c.Host + ":" + c.Port
+}
+
+// GRPCConfig configures the gRPC server, it listens on the host of the HTTP server
+type GRPCConfig struct {
+	Enabled bool   `yaml:"enabled" toml:"enabled"`
+	Port    string `yaml:"port" toml:"port"`
+}
+
+// GraphQLConfig configures the /graphql endpoint of the HTTP server
+type GraphQLConfig struct {
+	Enabled       bool `yaml:"enabled" toml:"enabled"`
+	MaxDepth      int  `yaml:"max_depth" toml:"max_depth"`
+	MaxComplexity int  `yaml:"max_complexity" toml:"max_complexity"`
+}
+
+// EventsConfig configures the subscription event stream and the retention of recorded events
+type EventsConfig struct {
+	PollInterval      time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval" toml:"heartbeat_interval"`
+	Retention         time.Duration `yaml:"retention" toml:"retention"`
+	CleanupInterval   time.Duration `yaml:"cleanup_interval" toml:"cleanup_interval"`
+}
+
+// CatalogConfig configures how service names of subscriptions are matched against the service catalog
+type CatalogConfig struct {
+	Mode string `yaml:"mode" toml:"mode"` // off, normalize or strict
+}
+
+// DatabaseConfig configures the PostgreSQL connection and pool
+type DatabaseConfig struct {
+	Host            string        `yaml:"host" toml:"host"`
+	Port            string        `yaml:"port" toml:"port"`
+	User            string        `yaml:"user" toml:"user"`
+	Password        string        `yaml:"password" toml:"password"`
+	Name            string        `yaml:"name" toml:"name"`
+	SSLMode         string        `yaml:"ssl_mode" toml:"ssl_mode"`
+	MaxOpenConns    int           `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" toml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" toml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" toml:"conn_max_idle_time"`
+	LegacyTenant    string        `yaml:"legacy_tenant" toml:"legacy_tenant"` // Tenant UUID that receives rows created before tenancy
+}
+
+// LegacyTenantID returns the legacy tenant, uuid.Nil when it is not set
+func (c DatabaseConfig) LegacyTenantID() uuid.UUID {
+	tenant, _ := uuid.Parse(c.LegacyTenant)
+	return tenant
+}
+
+// JWTConfig configures bearer token validation
+type JWTConfig struct {
+	Secret              string        `yaml:"secret" toml:"secret"`
+	JWKSFile            string        `yaml:"jwks_file" toml:"jwks_file"`
+	JWKSURL             string        `yaml:"jwks_url" toml:"jwks_url"`
+	Issuer              string        `yaml:"issuer" toml:"issuer"`
+	Audience            string        `yaml:"audience" toml:"audience"`
+	RolesClaim          string        `yaml:"roles_claim" toml:"roles_claim"`
+	TenantClaim         string        `yaml:"tenant_claim" toml:"tenant_claim"`
+	JWKSRefreshInterval time.Duration `yaml:"jwks_refresh_interval" toml:"jwks_refresh_interval"`
+}
+
+// IdempotencyConfig configures stored idempotent responses
+type IdempotencyConfig struct {
+	TTL             time.Duration `yaml:"ttl" toml:"ttl"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval" toml:"cleanup_interval"`
+}
+
+// RateLimitConfig configures per-client rate limiting
+type RateLimitConfig struct {
+	RPS        float64          `yaml:"rps" toml:"rps"`
+	Burst      int              `yaml:"burst" toml:"burst"`
+	IPRPS      float64          `yaml:"ip_rps" toml:"ip_rps"` // Per IP address before authentication
+	IPBurst    int              `yaml:"ip_burst" toml:"ip_burst"`
+	MaxClients int              `yaml:"max_clients" toml:"max_clients"`
+	Routes     []RateLimitRoute `yaml:"routes" toml:"routes"`
+}
+
+// MetricsConfig configures Prometheus metrics, they are served on the host of the HTTP server
+// on a separate port that is meant to be reachable from the internal network only
+type MetricsConfig struct {
+	Port            string        `yaml:"port" toml:"port"`
+	RefreshInterval time.Duration `yaml:"refresh_interval" toml:"refresh_interval"`
+}
+
+// TracingConfig configures OpenTelemetry tracing
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" toml:"exporter"` // none, otlp, stdout or file
+	ServiceName  string  `yaml:"service_name" toml:"service_name"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" toml:"otlp_endpoint"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" toml:"otlp_insecure"`
+	File         string  `yaml:"file" toml:"file"`
+	SampleRatio  float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+// Default returns the configuration with default values
+func Default() *Config {
+	return &Config{
+		Log: LogConfig{
+			Level:  DefaultLogLevel,
+			Format: DefaultLogFormat,
+		},
+		Server: ServerConfig{
+			ReadTimeout:     DefaultServerReadTimeout,
+			WriteTimeout:    DefaultServerWriteTimeout,
+			IdleTimeout:     DefaultServerIdleTimeout,
+			ShutdownTimeout: DefaultServerShutdownTimeout,
+		},
+		GRPC: GRPCConfig{
+			Enabled: DefaultGRPCEnabled,
+			Port:    DefaultGRPCPort,
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:       DefaultGraphQLEnabled,
+			MaxDepth:      DefaultGraphQLMaxDepth,
+			MaxComplexity: DefaultGraphQLMaxComplexity,
+		},
+		Events: EventsConfig{
+			PollInterval:      DefaultEventsPollInterval,
+			HeartbeatInterval: DefaultEventsHeartbeatInterval,
+			Retention:         DefaultEventsRetention,
+			CleanupInterval:   DefaultEventsCleanupInterval,
+		},
+		Catalog: CatalogConfig{
+			Mode: DefaultCatalogMode,
+		},
+		Database: DatabaseConfig{
+			SSLMode:         DefaultSSLMode,
+			MaxOpenConns:    DefaultDBMaxOpenConns,
+			MaxIdleConns:    DefaultDBMaxIdleConns,
+			ConnMaxLifetime: DefaultDBConnMaxLifetime,
+			ConnMaxIdleTime: DefaultDBConnMaxIdleTime,
+		},
+		JWT: JWTConfig{
+			RolesClaim:          DefaultJWTRolesClaim,
+			TenantClaim:         DefaultJWTTenantClaim,
+			JWKSRefreshInterval: DefaultJWTJWKSRefreshInterval,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             DefaultIdempotencyTTL,
+			CleanupInterval: DefaultIdempotencyCleanupInterval,
+		},
+		RateLimit: RateLimitConfig{
+			RPS:        DefaultRateLimitRPS,
+			Burst:      DefaultRateLimitBurst,
+			IPRPS:      DefaultRateLimitIPRPS,
+			IPBurst:    DefaultRateLimitIPBurst,
+			MaxClients: DefaultRateLimitMaxClients,
+		},
+		Metrics: MetricsConfig{
+			Port:            DefaultMetricsPort,
+			RefreshInterval: DefaultMetricsRefreshInterval,
+		},
+		Tracing: TracingConfig{
+			Exporter:    DefaultTracingExporter,
+			ServiceName: DefaultTracingServiceName,
+			SampleRatio: DefaultTracingSampleRatio,
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the optional file named by CONFIG_FILE
+// (YAML or TOML) and environment variables, which take precedence over the file.
+// Variables from the .env file of the current ENV are loaded into the environment first.
+// All invalid or missing settings are reported together in the returned error.
+func Load() (*Config, error) {
+	cfg, errs := load()
+	if cfg == nil {
+		return nil, errs[0]
+	}
+
+	errs = append(errs, cfg.Validate()...)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+
+	return cfg, nil
+}
+
+// LoadDatabase loads the configuration like Load but validates only the database
+// settings, for tools that connect to the database without running the server
+func LoadDatabase() (*DatabaseConfig, error) {
+	cfg, errs := load()
+	if cfg == nil {
+		return nil, errs[0]
+	}
+
+	errs = append(errs, cfg.Database.validate()...)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+
+	return &cfg.Database, nil
+}
+
+// LoadCatalog loads the configuration like Load but validates only the catalog settings,
+// for tools that change subscriptions without running the server
+func LoadCatalog() (*CatalogConfig, error) {
+	cfg, errs := load()
+	if cfg == nil {
+		return nil, errs[0]
+	}
+
+	errs = append(errs, cfg.Catalog.validate()...)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+
+	return &cfg.Catalog, nil
+}
+
+// load reads the configuration sources without validating the result.
+// A nil config means the config file could not be read and the only error explains why.
+func load() (*Config, []error) {
+	// Attempt to load variables from the .env file of the environment
 	env := determineEnvironment()
 	if err := godotenv.Load(env); err != nil {
-		logger.Error().Msg("No .env.local file found, using environment variables")
+		// The application logger is configured from the result of Load, use the global one
+		log.Debug().Str("file", env).Msg("No .env file found, using environment variables")
 	}
 
-	// Load and set environment variables with fallback values
-	LogLevel = optionalEnvStr("LOG_LEVEL", DefaultLogLevel)
+	cfg := Default()
 
-	DBHost = mustEnvStr("DB_HOST")
-	DBPort = mustEnvStr("DB_PORT")
-	DBUser = mustEnvStr("DB_USER")
-	DBPassword = mustEnvStr("DB_PASSWORD")
-	DBName = mustEnvStr("DB_NAME")
-	SSLMode = optionalEnvStr("DB_SSLMODE", DefaultSSLMode)
-
-	ServerHost = mustEnvStr("SERVER_HOST")
-	ServerPort = mustEnvStr("SERVER_PORT")
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return nil, []error{err}
+		}
+	}
 
-	return nil
+	return cfg, applyEnv(cfg)
 }
 
 func determineEnvironment() string {
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// envReader applies environment overrides and collects parse errors
+type envReader struct {
+	errs []error
+}
+
+// applyEnv overrides cfg with the environment variables that are set
+func applyEnv(cfg *Config) []error {
+	r := &envReader{}
+
+	r.str("LOG_LEVEL", &cfg.Log.Level)
+	r.str("LOG_FORMAT", &cfg.Log.Format)
+
+	r.str("SERVER_HOST", &cfg.Server.Host)
+	r.str("SERVER_PORT", &cfg.Server.Port)
+	r.duration("SERVER_READ_TIMEOUT", &cfg.Server.ReadTimeout)
+	r.duration("SERVER_WRITE_TIMEOUT", &cfg.Server.WriteTimeout)
+	r.duration("SERVER_IDLE_TIMEOUT", &cfg.Server.IdleTimeout)
+	r.duration("SERVER_SHUTDOWN_TIMEOUT", &cfg.Server.ShutdownTimeout)
+
+	r.bool("GRPC_ENABLED", &cfg.GRPC.Enabled)
+	r.str("GRPC_PORT", &cfg.GRPC.Port)
+
+	r.bool("GRAPHQL_ENABLED", &cfg.GraphQL.Enabled)
+	r.int("GRAPHQL_MAX_DEPTH", &cfg.GraphQL.MaxDepth)
+	r.int("GRAPHQL_MAX_COMPLEXITY", &cfg.GraphQL.MaxComplexity)
+
+	r.duration("EVENTS_POLL_INTERVAL", &cfg.Events.PollInterval)
+	r.duration("EVENTS_HEARTBEAT_INTERVAL", &cfg.Events.HeartbeatInterval)
+	r.duration("EVENTS_RETENTION", &cfg.Events.Retention)
+	r.duration("EVENTS_CLEANUP_INTERVAL", &cfg.Events.CleanupInterval)
+
+	r.str("CATALOG_MODE", &cfg.Catalog.Mode)
+
+	r.str("DB_HOST", &cfg.Database.Host)
+	r.str("DB_PORT", &cfg.Database.Port)
+	r.str("DB_USER", &cfg.Database.User)
+	r.str("DB_PASSWORD", &cfg.Database.Password)
+	r.str("DB_NAME", &cfg.Database.Name)
+	r.str("DB_SSLMODE", &cfg.Database.SSLMode)
+	r.int("DB_MAX_OPEN_CONNS", &cfg.Database.MaxOpenConns)
+	r.int("DB_MAX_IDLE_CONNS", &cfg.Database.MaxIdleConns)
+	r.duration("DB_CONN_MAX_LIFETIME", &cfg.Database.ConnMaxLifetime)
+	r.duration("DB_CONN_MAX_IDLE_TIME", &cfg.Database.ConnMaxIdleTime)
+	r.str("DB_LEGACY_TENANT", &cfg.Database.LegacyTenant)
+
+	r.str("JWT_SECRET", &cfg.JWT.Secret)
+	r.str("JWT_JWKS_FILE", &cfg.JWT.JWKSFile)
+	r.str("JWT_JWKS_URL", &cfg.JWT.JWKSURL)
+	r.str("JWT_ISSUER", &cfg.JWT.Issuer)
+	r.str("JWT_AUDIENCE", &cfg.JWT.Audience)
+	r.str("JWT_ROLES_CLAIM", &cfg.JWT.RolesClaim)
+	r.str("JWT_TENANT_CLAIM", &cfg.JWT.TenantClaim)
+	r.duration("JWT_JWKS_REFRESH_INTERVAL", &cfg.JWT.JWKSRefreshInterval)
+
+	r.duration("IDEMPOTENCY_TTL", &cfg.Idempotency.TTL)
+	r.duration("IDEMPOTENCY_CLEANUP_INTERVAL", &cfg.Idempotency.CleanupInterval)
+
+	r.float("RATE_LIMIT_RPS", &cfg.RateLimit.RPS)
+	r.int("RATE_LIMIT_BURST", &cfg.RateLimit.Burst)
+	r.float("RATE_LIMIT_IP_RPS", &cfg.RateLimit.IPRPS)
+	r.int("RATE_LIMIT_IP_BURST", &cfg.RateLimit.IPBurst)
+	r.int("RATE_LIMIT_MAX_CLIENTS", &cfg.RateLimit.MaxClients)
+	if spec, ok := os.LookupEnv("RATE_LIMIT_ROUTES"); ok {
+		routes, err := parseRateLimitRoutes(spec)
+		if err != nil {
+			r.errs = append(r.errs, fmt.Errorf("RATE_LIMIT_ROUTES: %w", err))
+		} else {
+			cfg.RateLimit.Routes = routes
+		}
+	}
+
+	r.str("METRICS_PORT", &cfg.Metrics.Port)
+	r.duration("METRICS_REFRESH_INTERVAL", &cfg.Metrics.RefreshInterval)
+
+	r.str("TRACING_EXPORTER", &cfg.Tracing.Exporter)
+	r.str("TRACING_SERVICE_NAME", &cfg.Tracing.ServiceName)
+	r.str("TRACING_OTLP_ENDPOINT", &cfg.Tracing.OTLPEndpoint)
+	r.bool("TRACING_OTLP_INSECURE", &cfg.Tracing.OTLPInsecure)
+	r.str("TRACING_FILE", &cfg.Tracing.File)
+	r.float("TRACING_SAMPLE_RATIO", &cfg.Tracing.SampleRatio)
+
+	return r.errs
+}
+
+func (r *envReader) str(key string, dst *string) {
+	if env, ok := os.LookupEnv(key); ok {
+		*dst = env
+	}
+}
+
+func (r *envReader) int(key string, dst *int) {
+	env, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	v, err := strconv.Atoi(env)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid integer %q", key, env))
+		return
+	}
+	*dst = v
+}
+
+func (r *envReader) float(key string, dst *float64) {
+	env, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	v, err := strconv.ParseFloat(env, 64)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid number %q", key, env))
+		return
+	}
+	*dst = v
+}
+
+func (r *envReader) bool(key string, dst *bool) {
+	env, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	v, err := strconv.ParseBool(env)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid boolean %q", key, env))
+		return
+	}
+	*dst = v
+}
+
+func (r *envReader) duration(key string, dst *time.Duration) {
+	env, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	v, err := time.ParseDuration(env)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid duration %q", key, env))
+		return
+	}
+	*dst = v
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// RateLimitRoute is a route-specific rate limit policy
+type RateLimitRoute struct {
+	Method     string  `yaml:"method" toml:"method"` // Empty matches any method
+	PathPrefix string  `yaml:"path_prefix" toml:"path_prefix"`
+	RPS        float64 `yaml:"rps" toml:"rps"`
+	Burst      int     `yaml:"burst" toml:"burst"`
+}
+
+// validate checks the route policy
+func (r RateLimitRoute) validate() error {
+	if !strings.HasPrefix(r.PathPrefix, "/") {
+		return fmt.Errorf("path prefix %q must start with /", r.PathPrefix)
+	}
+	if r.Method != "" && !isHTTPMethod(strings.ToUpper(r.Method)) {
+		return fmt.Errorf("unknown method %s", r.Method)
+	}
+	if r.RPS < 0 {
+		return fmt.Errorf("rps must not be negative")
+	}
+	if r.Burst < 1 {
+		return fmt.Errorf("burst must be a positive integer")
+	}
+	return nil
+}
+
+// parseRateLimitRoutes parses a comma-separated list of "[METHOD ]/path/prefix=rps:burst" policies,
+// e.g. "POST /subscriptions=5:10,/subscriptions/summary/total-cost=1:3"
+func parseRateLimitRoutes(spec string) ([]RateLimitRoute, error) {
+	var routes []RateLimitRoute
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		target, limits, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("rate limit route %q: expected \"[METHOD ]/path=rps:burst\"", item)
+		}
+
+		route := RateLimitRoute{PathPrefix: strings.TrimSpace(target)}
+		if method, path, hasMethod := strings.Cut(route.PathPrefix, " "); hasMethod {
+			route.Method = strings.ToUpper(method)
+			route.PathPrefix = strings.TrimSpace(path)
+		}
+
+		rps, burst, ok := strings.Cut(limits, ":")
+		if !ok {
+			return nil, fmt.Errorf("rate limit route %q: expected rps:burst", item)
+		}
+
+		var err error
+		if route.RPS, err = strconv.ParseFloat(strings.TrimSpace(rps), 64); err != nil {
+			return nil, fmt.Errorf("rate limit route %q: invalid rps %q", item, rps)
+		}
+		if route.Burst, err = strconv.Atoi(strings.TrimSpace(burst)); err != nil {
+			return nil, fmt.Errorf("rate limit route %q: invalid burst %q", item, burst)
+		}
+
+		if err = route.validate(); err != nil {
+			return nil, fmt.Errorf("rate limit route %q: %w", item, err)
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+func isHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
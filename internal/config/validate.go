@@ -0,0 +1,198 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// Validate checks the whole configuration and returns every problem found
+func (c *Config) Validate() []error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if _, err := zerolog.ParseLevel(c.Log.Level); err != nil {
+		fail("log.level: unknown level %q", c.Log.Level)
+	}
+	if c.Log.Format != "console" && c.Log.Format != "json" {
+		fail("log.format: must be console or json, got %q", c.Log.Format)
+	}
+
+	if c.Server.Host == "" {
+		fail("server.host (SERVER_HOST) is required")
+	}
+	if !isPort(c.Server.Port) {
+		fail("server.port (SERVER_PORT) must be a port number, got %q", c.Server.Port)
+	}
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"server.read_timeout", c.Server.ReadTimeout},
+		{"server.write_timeout", c.Server.WriteTimeout},
+		{"server.idle_timeout", c.Server.IdleTimeout},
+		{"server.shutdown_timeout", c.Server.ShutdownTimeout},
+	} {
+		if timeout.value <= 0 {
+			fail("%s must be positive", timeout.name)
+		}
+	}
+
+	if c.GRPC.Enabled {
+		if !isPort(c.GRPC.Port) {
+			fail("grpc.port (GRPC_PORT) must be a port number, got %q", c.GRPC.Port)
+		} else if c.GRPC.Port == c.Server.Port {
+			fail("grpc.port (GRPC_PORT) must differ from server.port")
+		}
+	}
+
+	if c.GraphQL.Enabled {
+		if c.GraphQL.MaxDepth < 1 {
+			fail("graphql.max_depth (GRAPHQL_MAX_DEPTH) must be positive")
+		}
+		if c.GraphQL.MaxComplexity < 1 {
+			fail("graphql.max_complexity (GRAPHQL_MAX_COMPLEXITY) must be positive")
+		}
+	}
+
+	for _, interval := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"events.poll_interval", c.Events.PollInterval},
+		{"events.heartbeat_interval", c.Events.HeartbeatInterval},
+		{"events.retention", c.Events.Retention},
+		{"events.cleanup_interval", c.Events.CleanupInterval},
+	} {
+		if interval.value <= 0 {
+			fail("%s must be positive", interval.name)
+		}
+	}
+
+	errs = append(errs, c.Catalog.validate()...)
+	errs = append(errs, c.Database.validate()...)
+
+	if c.JWT.Secret == "" && c.JWT.JWKSFile == "" && c.JWT.JWKSURL == "" {
+		fail("jwt: one of secret (JWT_SECRET), jwks_file (JWT_JWKS_FILE) or jwks_url (JWT_JWKS_URL) is required")
+	}
+	if c.JWT.JWKSFile != "" && c.JWT.JWKSURL != "" {
+		fail("jwt: jwks_file and jwks_url are mutually exclusive")
+	}
+	if c.JWT.TenantClaim == "" {
+		fail("jwt.tenant_claim must not be empty")
+	}
+
+	if c.Idempotency.TTL <= 0 {
+		fail("idempotency.ttl must be positive")
+	}
+	if c.Idempotency.CleanupInterval <= 0 {
+		fail("idempotency.cleanup_interval must be positive")
+	}
+
+	if c.RateLimit.RPS < 0 {
+		fail("rate_limit.rps must not be negative")
+	}
+	if c.RateLimit.RPS > 0 && c.RateLimit.Burst < 1 {
+		fail("rate_limit.burst must be positive")
+	}
+	if c.RateLimit.IPRPS < 0 {
+		fail("rate_limit.ip_rps must not be negative")
+	}
+	if c.RateLimit.IPRPS > 0 && c.RateLimit.IPBurst < 1 {
+		fail("rate_limit.ip_burst must be positive")
+	}
+	if c.RateLimit.MaxClients < 1 {
+		fail("rate_limit.max_clients must be positive")
+	}
+	for i, route := range c.RateLimit.Routes {
+		if err := route.validate(); err != nil {
+			fail("rate_limit.routes[%d]: %v", i, err)
+		}
+	}
+
+	switch {
+	case !isPort(c.Metrics.Port):
+		fail("metrics.port (METRICS_PORT) must be a port number, got %q", c.Metrics.Port)
+	case c.Metrics.Port == c.Server.Port:
+		fail("metrics.port (METRICS_PORT) must differ from server.port")
+	case c.GRPC.Enabled && c.Metrics.Port == c.GRPC.Port:
+		fail("metrics.port (METRICS_PORT) must differ from grpc.port")
+	}
+	if c.Metrics.RefreshInterval <= 0 {
+		fail("metrics.refresh_interval must be positive")
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "otlp", "stdout":
+	case "file":
+		if c.Tracing.File == "" {
+			fail("tracing.file (TRACING_FILE) is required for the file exporter")
+		}
+	default:
+		fail("tracing.exporter: must be none, otlp, stdout or file, got %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sample_ratio must be between 0 and 1")
+	}
+
+	return errs
+}
+
+// validate checks the catalog settings
+func (c CatalogConfig) validate() []error {
+	switch c.Mode {
+	case "off", "normalize", "strict":
+		return nil
+	default:
+		return []error{fmt.Errorf("catalog.mode (CATALOG_MODE): must be off, normalize or strict, got %q", c.Mode)}
+	}
+}
+
+// validate checks the database settings
+func (c DatabaseConfig) validate() []error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Host == "" {
+		fail("database.host (DB_HOST) is required")
+	}
+	if !isPort(c.Port) {
+		fail("database.port (DB_PORT) must be a port number, got %q", c.Port)
+	}
+	if c.User == "" {
+		fail("database.user (DB_USER) is required")
+	}
+	if c.Name == "" {
+		fail("database.name (DB_NAME) is required")
+	}
+	switch c.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		fail("database.ssl_mode (DB_SSLMODE): unknown mode %q", c.SSLMode)
+	}
+	if c.MaxOpenConns < 1 {
+		fail("database.max_open_conns must be positive")
+	}
+	if c.MaxIdleConns < 0 || c.MaxIdleConns > c.MaxOpenConns {
+		fail("database.max_idle_conns must be between 0 and max_open_conns")
+	}
+	if c.LegacyTenant != "" {
+		if tenant, err := uuid.Parse(c.LegacyTenant); err != nil || tenant == uuid.Nil {
+			fail("database.legacy_tenant (DB_LEGACY_TENANT) must be a non-nil UUID, got %q", c.LegacyTenant)
+		}
+	}
+
+	return errs
+}
+
+func isPort(s string) bool {
+	port, err := strconv.Atoi(s)
+	return err == nil && port > 0 && port <= 65535
+}
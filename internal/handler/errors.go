@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"net/http"
+
+	"subscription/internal/handler/problem"
+)
+
+// writeProblem writes an error response of the given status as problem details
+func writeProblem(w http.ResponseWriter, r *http.Request, statusCode int, detail string) {
+	problem.Write(w, r, problem.New(r.Context(), statusCode, detail))
+}
+
+// writeError writes err as problem details with the status code of the domain error
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Write(w, r, problem.FromError(r.Context(), err))
+}
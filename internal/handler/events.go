@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+)
+
+const (
+	// eventBatchSize is the number of events read from the store at once
+	eventBatchSize = 100
+	// eventRetryDelay is the reconnection delay suggested to clients
+	eventRetryDelay = 3 * time.Second
+)
+
+// EventStreamConfig configures the subscription event stream
+type EventStreamConfig struct {
+	// PollInterval is how often the stream looks for new events
+	PollInterval time.Duration
+	// HeartbeatInterval is how often a comment is sent to keep idle connections open
+	HeartbeatInterval time.Duration
+	// WriteTimeout bounds every write, the stream outlives the WriteTimeout of the server
+	// by moving the write deadline before each write
+	WriteTimeout time.Duration
+}
+
+// eventPayload is the data of a subscription event
+type eventPayload struct {
+	OccurredAt   time.Time           `json:"occurred_at"`
+	Subscription subscriptionPayload `json:"subscription"`
+}
+
+// subscriptionPayload follows the field order of the REST Subscription schema
+type subscriptionPayload struct {
+	ID           uuid.UUID     `json:"id"`
+	ServiceName  string        `json:"service_name"`
+	Price        moneyPayload  `json:"price"`
+	UserID       uuid.UUID     `json:"user_id"`
+	StartDate    string        `json:"start_date"`
+	EndDate      *string       `json:"end_date,omitempty"`
+	TrialEndDate *string       `json:"trial_end_date,omitempty"`
+	Status       domain.Status `json:"status"`
+}
+
+// moneyPayload follows the REST Money schema
+type moneyPayload struct {
+	Amount   int64           `json:"amount"`
+	Currency domain.Currency `json:"currency"`
+}
+
+// SubscriptionEventsHandler streams subscription changes as Server-Sent Events.
+// The stream starts after the Last-Event-ID header (or last_event_id query parameter) when given,
+// otherwise with the changes made after the connection was opened.
+func SubscriptionEventsHandler(service ports.SubscriptionService, cfg EventStreamConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeProblem(w, r, http.StatusMethodNotAllowed, "only GET is allowed")
+			return
+		}
+
+		ctx := r.Context()
+		log := logger.WithRequestContext(ctx, getRequestID(r))
+
+		filter, err := parseEventFilter(r.URL.Query())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		lastID, resume, err := parseLastEventID(r)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if !resume {
+			if lastID, err = service.LastSubscriptionEventID(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to get last subscription event ID")
+				writeError(w, r, err)
+				return
+			}
+		}
+
+		// The first read also checks access, errors are still reported as regular responses
+		events, err := service.ListSubscriptionEvents(ctx, lastID, filter, eventBatchSize)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list subscription events")
+			writeError(w, r, err)
+			return
+		}
+
+		stream := &eventStream{w: w, rc: http.NewResponseController(w), writeTimeout: cfg.WriteTimeout}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		if err = stream.write(fmt.Sprintf("retry: %d\n\n", eventRetryDelay.Milliseconds())); err != nil {
+			return
+		}
+
+		poll := time.NewTicker(cfg.PollInterval)
+		defer poll.Stop()
+		heartbeat := time.NewTicker(cfg.HeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			for _, event := range events {
+				if err = stream.event(event); err != nil {
+					log.Debug().Err(err).Msg("Subscription event stream closed")
+					return
+				}
+				lastID = event.ID
+			}
+
+			// A full batch means more events are waiting
+			if len(events) < eventBatchSize {
+				select {
+				case <-ctx.Done():
+					return
+				case <-heartbeat.C:
+					if err = stream.write(": heartbeat\n\n"); err != nil {
+						log.Debug().Err(err).Msg("Subscription event stream closed")
+						return
+					}
+					events = nil
+					continue
+				case <-poll.C:
+				}
+			}
+
+			events, err = service.ListSubscriptionEvents(ctx, lastID, filter, eventBatchSize)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				// The client keeps its position, the next poll retries
+				log.Error().Err(err).Int64("last_event_id", lastID).Msg("Failed to list subscription events")
+				events = nil
+			}
+		}
+	})
+}
+
+// eventStream writes Server-Sent Events and flushes them immediately
+type eventStream struct {
+	w            http.ResponseWriter
+	rc           *http.ResponseController
+	writeTimeout time.Duration
+}
+
+func (s *eventStream) event(event *domain.SubscriptionEvent) error {
+	sub := event.Subscription
+	data, err := json.Marshal(eventPayload{
+		OccurredAt: event.OccurredAt,
+		Subscription: subscriptionPayload{
+			ID:           sub.ID,
+			ServiceName:  sub.ServiceName,
+			Price:        moneyPayload{Amount: sub.Price.Amount, Currency: sub.Price.Currency},
+			UserID:       sub.UserID,
+			StartDate:    sub.StartDate,
+			EndDate:      sub.EndDate,
+			TrialEndDate: sub.TrialEndDate,
+			Status:       sub.Status(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data))
+}
+
+func (s *eventStream) write(message string) error {
+	// Writers that cannot move the deadline have none to outlive
+	if err := s.rc.SetWriteDeadline(time.Now().Add(s.writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	if _, err := s.w.Write([]byte(message)); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// parseEventFilter reads the comma-separated user_ids and service_names query parameters
+func parseEventFilter(query url.Values) (ports.SubscriptionEventFilter, error) {
+	var filter ports.SubscriptionEventFilter
+
+	for _, value := range splitQueryList(query.Get("user_ids")) {
+		userID, err := uuid.Parse(value)
+		if err != nil {
+			return filter, domain.NewValidationError("user_ids", "must be comma-separated UUIDs")
+		}
+		filter.UserIDs = append(filter.UserIDs, userID)
+	}
+	filter.ServiceNames = splitQueryList(query.Get("service_names"))
+
+	return filter, nil
+}
+
+// parseLastEventID returns the ID to resume after, resume is false when the client sent none
+func parseLastEventID(r *http.Request) (lastID int64, resume bool, err error) {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return 0, false, nil
+	}
+
+	lastID, err = strconv.ParseInt(value, 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, false, domain.NewValidationError("last_event_id", "must be a non-negative integer")
+	}
+	return lastID, true, nil
+}
+
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// RunSubscriptionEventJanitor periodically removes subscription events older than retention until ctx is canceled
+func RunSubscriptionEventJanitor(ctx context.Context, repo ports.SubscriptionRepository, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := repo.DeleteEventsBefore(ctx, time.Now().Add(-retention))
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to purge old subscription events")
+				continue
+			}
+			logger.Debug().Int64("deleted", deleted).Msg("Old subscription events purged")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	graphql1 "subscription/internal/api/graphql"
+	"subscription/internal/logger"
+)
+
+// requestIDKey is the context key the HTTP middleware stores the request ID under
+const requestIDKey = "request_id"
+
+// Config limits the cost of GraphQL operations
+type Config struct {
+	// MaxDepth is the deepest allowed nesting of fields
+	MaxDepth int
+	// MaxComplexity is the highest allowed complexity, list fields count once per item they can return
+	MaxComplexity int
+}
+
+// NewHandler creates the HTTP handler of the GraphQL endpoint.
+// Authentication, rate limiting and logging are left to the middleware chain it is mounted in.
+func NewHandler(service ports.SubscriptionService, cfg Config) http.Handler {
+	server := handler.New(graphql1.NewExecutableSchema(graphql1.Config{
+		Resolvers:  NewResolver(service),
+		Complexity: complexity(),
+	}))
+
+	server.AddTransport(transport.GET{})
+	server.AddTransport(transport.POST{})
+
+	server.Use(extension.Introspection{})
+	server.Use(depthLimit{max: cfg.MaxDepth})
+	server.Use(extension.FixedComplexityLimit(cfg.MaxComplexity))
+
+	server.SetErrorPresenter(presentError)
+	server.SetRecoverFunc(func(ctx context.Context, recovered any) error {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().
+			Err(fmt.Errorf("panic: %v", recovered)).
+			Msg("Recovered from panic")
+		return domain.ErrInternal
+	})
+
+	return server
+}
+
+// presentError exposes the code, status and field errors of domain errors in the error extensions.
+// Errors that are not domain errors are reported as internal without their details.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
+		var operationErr *gqlerror.Error
+		if errors.As(err, &operationErr) {
+			// Parsing, validation, argument coercion and limit errors of the operation itself
+			return gqlErr
+		}
+
+		gqlErr.Message = domain.ErrInternal.Error()
+		gqlErr.Extensions = map[string]interface{}{
+			"code":   "internal_error",
+			"status": http.StatusInternalServerError,
+		}
+		return gqlErr
+	}
+
+	gqlErr.Message = domainErr.Message
+	gqlErr.Extensions = map[string]interface{}{
+		"code":   errorCode(err, domainErr),
+		"status": domainErr.Code,
+	}
+	if fieldErrs := domain.FieldErrors(err); len(fieldErrs) > 0 {
+		gqlErr.Extensions["errors"] = fieldErrs
+	}
+
+	return gqlErr
+}
+
+func errorCode(err error, domainErr *domain.DomainError) string {
+	// Overlaps are the one conflict clients resolve differently
+	if domain.IsOverlapError(err) {
+		return "overlapping_subscription"
+	}
+
+	switch domainErr.Code {
+	case domain.ValidationError:
+		return "validation_error"
+	case domain.UnauthorizedError:
+		return "unauthorized"
+	case domain.ForbiddenError:
+		return "forbidden"
+	case domain.NotFoundError:
+		return "not_found"
+	case domain.ConflictError:
+		return "conflict"
+	case domain.UnprocessableEntityError:
+		return "unprocessable_entity"
+	default:
+		return "internal_error"
+	}
+}
+
+func getRequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok && requestID != "" {
+		return requestID
+	}
+	return "unknown"
+}
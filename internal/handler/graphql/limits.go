@@ -0,0 +1,112 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"subscription/core/ports"
+	graphql1 "subscription/internal/api/graphql"
+)
+
+const (
+	errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+	// maxPageLimit mirrors the limit the service clamps pages to
+	maxPageLimit = 100
+)
+
+// depthLimit rejects operations whose selections are nested deeper than max
+type depthLimit struct {
+	max int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit{}
+
+func (d depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d depthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	if depth := selectionDepth(op.SelectionSet); depth > d.max {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.max)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// selectionDepth returns the number of nested field levels of the selection set.
+// Introspection fields are not counted, the standard introspection query is deeply nested.
+// Fragment cycles are rejected by validation before operation context mutators run.
+func selectionDepth(selections ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selections {
+		var current int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name, "__") {
+				continue
+			}
+			current = 1 + selectionDepth(selection.SelectionSet)
+		case *ast.InlineFragment:
+			current = selectionDepth(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				current = selectionDepth(selection.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, current)
+	}
+	return depth
+}
+
+// complexity weighs list fields by the number of items they can return
+func complexity() graphql1.ComplexityRoot {
+	var root graphql1.ComplexityRoot
+
+	root.Query.Subscriptions = func(childComplexity int, _ *ports.SubscriptionFilter, _ int, limit int) int {
+		return 1 + pageLimit(limit)*childComplexity
+	}
+	root.Query.EndingTrials = func(childComplexity int, _ int, _ *ports.SubscriptionFilter, _ int, limit int) int {
+		return 1 + pageLimit(limit)*childComplexity
+	}
+	root.Query.Users = func(childComplexity int, ids []uuid.UUID) int {
+		return 1 + len(ids)*childComplexity
+	}
+	root.User.Subscriptions = func(childComplexity int, _ *ports.SubscriptionFilter, _ int, limit int) int {
+		return 1 + pageLimit(limit)*childComplexity
+	}
+
+	return root
+}
+
+// pageLimit returns the page size the service applies for the requested limit
+func pageLimit(limit int) int {
+	switch {
+	case limit < 1:
+		return 20
+	case limit > maxPageLimit:
+		return maxPageLimit
+	default:
+		return limit
+	}
+}
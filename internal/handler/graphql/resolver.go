@@ -0,0 +1,208 @@
+package graphql
+
+// THIS CODE WILL BE UPDATED WITH SCHEMA CHANGES. PREVIOUS IMPLEMENTATION FOR SCHEMA CHANGES WILL BE KEPT IN THE COMMENT SECTION. IMPLEMENTATION FOR UNCHANGED SCHEMA WILL BE KEPT.
+
+import (
+	"context"
+	"errors"
+	"subscription/core/domain"
+	"subscription/core/ports"
+	graphql1 "subscription/internal/api/graphql"
+	"subscription/internal/logger"
+
+	"github.com/google/uuid"
+)
+
+// Resolver serves the GraphQL schema with ports.SubscriptionService
+type Resolver struct {
+	service ports.SubscriptionService
+}
+
+func NewResolver(service ports.SubscriptionService) *Resolver {
+	return &Resolver{service: service}
+}
+
+// Currency is the resolver for the currency field.
+func (r *costSummaryResolver) Currency(ctx context.Context, obj *ports.TotalCostResponse) (string, error) {
+	return string(obj.Currency), nil
+}
+
+// Currency is the resolver for the currency field.
+func (r *moneyResolver) Currency(ctx context.Context, obj *domain.Money) (string, error) {
+	return string(obj.Currency), nil
+}
+
+// Subscription is the resolver for the subscription field.
+func (r *queryResolver) Subscription(ctx context.Context, id uuid.UUID) (*domain.Subscription, error) {
+	subscription, err := r.service.GetSubscription(ctx, id)
+	if errors.Is(err, domain.ErrSubscriptionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to get subscription")
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// Subscriptions is the resolver for the subscriptions field.
+func (r *queryResolver) Subscriptions(ctx context.Context, filter *ports.SubscriptionFilter, page int, limit int) (*graphql1.SubscriptionPage, error) {
+	var subscriptionFilter ports.SubscriptionFilter
+	if filter != nil {
+		subscriptionFilter = *filter
+	}
+	return r.listSubscriptions(ctx, subscriptionFilter, page, limit)
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id uuid.UUID) (*graphql1.User, error) {
+	return &graphql1.User{ID: id}, nil
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, ids []uuid.UUID) ([]*graphql1.User, error) {
+	users := make([]*graphql1.User, len(ids))
+	for i, id := range ids {
+		users[i] = &graphql1.User{ID: id}
+	}
+	return users, nil
+}
+
+// EndingTrials is the resolver for the endingTrials field.
+func (r *queryResolver) EndingTrials(ctx context.Context, withinMonths int, filter *ports.SubscriptionFilter, page int, limit int) (*graphql1.SubscriptionPage, error) {
+	var subscriptionFilter ports.SubscriptionFilter
+	if filter != nil {
+		subscriptionFilter = *filter
+	}
+
+	subscriptions, meta, err := r.service.ListEndingTrials(ctx, withinMonths, subscriptionFilter, ports.Pagination{Page: page, Limit: limit})
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(err).Msg("Failed to list ending trials")
+		return nil, err
+	}
+	return &graphql1.SubscriptionPage{Items: subscriptions, PageInfo: meta}, nil
+}
+
+// TotalCost is the resolver for the totalCost field.
+func (r *queryResolver) TotalCost(ctx context.Context, startDate string, endDate string, userIds []uuid.UUID, serviceNames []string, categories []string, tags []string, groupBy *ports.CostGroup, attribution *ports.CostAttribution, currency *string) (*ports.TotalCostResponse, error) {
+	return r.totalCost(ctx, &ports.TotalCostRequest{
+		StartDate:    startDate,
+		EndDate:      endDate,
+		UserIDs:      userIds,
+		ServiceNames: serviceNames,
+		Categories:   categories,
+		Tags:         tags,
+		GroupBy:      costGroupOrNone(groupBy),
+		Attribution:  attributionOrNone(attribution),
+		Currency:     currencyOrNone(currency),
+	})
+}
+
+// User is the resolver for the user field.
+func (r *subscriptionResolver) User(ctx context.Context, obj *domain.Subscription) (*graphql1.User, error) {
+	return &graphql1.User{ID: obj.UserID}, nil
+}
+
+// Split is the resolver for the split field.
+func (r *subscriptionResolver) Split(ctx context.Context, obj *domain.Subscription) (*domain.SplitRule, error) {
+	if obj.Split == "" {
+		return nil, nil
+	}
+	return &obj.Split, nil
+}
+
+// Subscriptions is the resolver for the subscriptions field.
+func (r *userResolver) Subscriptions(ctx context.Context, obj *graphql1.User, filter *ports.SubscriptionFilter, page int, limit int) (*graphql1.SubscriptionPage, error) {
+	var subscriptionFilter ports.SubscriptionFilter
+	if filter != nil {
+		subscriptionFilter = *filter
+	}
+	subscriptionFilter.UserIDs = []uuid.UUID{obj.ID}
+
+	return r.listSubscriptions(ctx, subscriptionFilter, page, limit)
+}
+
+// SubscriptionCount is the resolver for the subscriptionCount field.
+func (r *userResolver) SubscriptionCount(ctx context.Context, obj *graphql1.User) (int, error) {
+	// The pagination metadata carries the count, a single row is enough to get it
+	page, err := r.listSubscriptions(ctx, ports.SubscriptionFilter{UserIDs: []uuid.UUID{obj.ID}}, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return page.PageInfo.Total, nil
+}
+
+// TotalCost is the resolver for the totalCost field.
+func (r *userResolver) TotalCost(ctx context.Context, obj *graphql1.User, startDate string, endDate string, serviceNames []string, categories []string, tags []string, groupBy *ports.CostGroup, attribution *ports.CostAttribution, currency *string) (*ports.TotalCostResponse, error) {
+	return r.totalCost(ctx, &ports.TotalCostRequest{
+		StartDate:    startDate,
+		EndDate:      endDate,
+		UserIDs:      []uuid.UUID{obj.ID},
+		ServiceNames: serviceNames,
+		Categories:   categories,
+		Tags:         tags,
+		GroupBy:      costGroupOrNone(groupBy),
+		Attribution:  attributionOrNone(attribution),
+		Currency:     currencyOrNone(currency),
+	})
+}
+
+// CostSummary returns graphql1.CostSummaryResolver implementation.
+func (r *Resolver) CostSummary() graphql1.CostSummaryResolver { return &costSummaryResolver{r} }
+
+// Money returns graphql1.MoneyResolver implementation.
+func (r *Resolver) Money() graphql1.MoneyResolver { return &moneyResolver{r} }
+
+// Query returns graphql1.QueryResolver implementation.
+func (r *Resolver) Query() graphql1.QueryResolver { return &queryResolver{r} }
+
+// Subscription returns graphql1.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() graphql1.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// User returns graphql1.UserResolver implementation.
+func (r *Resolver) User() graphql1.UserResolver { return &userResolver{r} }
+
+type costSummaryResolver struct{ *Resolver }
+type moneyResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
+
+func (r *Resolver) listSubscriptions(ctx context.Context, filter ports.SubscriptionFilter, page, limit int) (*graphql1.SubscriptionPage, error) {
+	subscriptions, meta, err := r.service.ListSubscriptions(ctx, filter, ports.Pagination{Page: page, Limit: limit})
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(err).Msg("Failed to list subscriptions")
+		return nil, err
+	}
+	return &graphql1.SubscriptionPage{Items: subscriptions, PageInfo: meta}, nil
+}
+
+func (r *Resolver) totalCost(ctx context.Context, req *ports.TotalCostRequest) (*ports.TotalCostResponse, error) {
+	result, err := r.service.GetTotalCost(ctx, req)
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(err).Msg("Failed to calculate total cost")
+		return nil, err
+	}
+	return result, nil
+}
+
+func costGroupOrNone(groupBy *ports.CostGroup) ports.CostGroup {
+	if groupBy == nil {
+		return ""
+	}
+	return *groupBy
+}
+
+func attributionOrNone(attribution *ports.CostAttribution) ports.CostAttribution {
+	if attribution == nil {
+		return ""
+	}
+	return *attribution
+}
+
+func currencyOrNone(currency *string) domain.Currency {
+	if currency == nil {
+		return ""
+	}
+	return domain.Currency(*currency)
+}
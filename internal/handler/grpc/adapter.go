@@ -0,0 +1,356 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	pb "subscription/internal/api/grpc/subscription/v1"
+	"subscription/internal/logger"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 20
+)
+
+// GRPCAdapter serves the gRPC SubscriptionService with ports.SubscriptionService
+type GRPCAdapter struct {
+	pb.UnimplementedSubscriptionServiceServer
+
+	service ports.SubscriptionService
+}
+
+func NewGRPCAdapter(service ports.SubscriptionService) *GRPCAdapter {
+	return &GRPCAdapter{service: service}
+}
+
+// Ensure interface implementation
+var _ pb.SubscriptionServiceServer = (*GRPCAdapter)(nil)
+
+// CreateSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	members, err := convertMembersFromProto(req.GetMembers())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.CreateSubscription(ctx, &ports.CreateSubscriptionRequest{
+		ServiceName:  req.GetServiceName(),
+		Price:        convertMoneyFromProto(req.GetPrice()),
+		TaxRate:      int(req.GetTaxRate()),
+		TaxInclusive: req.TaxInclusive,
+		UserID:       userID,
+		StartDate:    req.GetStartDate(),
+		EndDate:      req.EndDate,
+		TrialEndDate: req.TrialEndDate,
+		Category:     req.GetCategory(),
+		Tags:         req.GetTags(),
+		Split:        domain.SplitRule(req.GetSplit()),
+		Members:      members,
+		Discounts:    convertDiscountsFromProto(req.GetDiscounts()),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// GetSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.GetSubscription(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to get subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// ListSubscriptions implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	userIDs, err := parseUUIDs("user_ids", req.GetUserIds())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	filter := ports.SubscriptionFilter{
+		UserIDs:       userIDs,
+		ServiceNames:  req.GetServiceNames(),
+		StartDateFrom: req.StartDateFrom,
+		StartDateTo:   req.StartDateTo,
+		Statuses:      convertStatusesFromProto(req.GetStatuses()),
+		Categories:    req.GetCategories(),
+		Tags:          req.GetTags(),
+	}
+
+	pagination := ports.Pagination{
+		Page:  intOrDefault(req.GetPage(), defaultPage),
+		Limit: intOrDefault(req.GetLimit(), defaultLimit),
+	}
+
+	subscriptions, meta, err := a.service.ListSubscriptions(ctx, filter, pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subscriptions")
+		return nil, toStatus(err)
+	}
+
+	return &pb.ListSubscriptionsResponse{
+		Data:       convertSubscriptionsToProto(subscriptions),
+		Pagination: convertPaginationToProto(meta),
+	}, nil
+}
+
+// PauseSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) PauseSubscription(ctx context.Context, req *pb.PauseSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.PauseSubscription(ctx, id, &ports.PauseSubscriptionRequest{
+		StartDate: req.GetStartDate(),
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to pause subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// ResumeSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) ResumeSubscription(ctx context.Context, req *pb.ResumeSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.ResumeSubscription(ctx, id, &ports.ResumeSubscriptionRequest{
+		Date: req.GetDate(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to resume subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// CancelSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.CancelSubscription(ctx, id, &ports.CancelSubscriptionRequest{
+		EndDate: req.GetEndDate(),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to cancel subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// ListEndingTrials implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) ListEndingTrials(ctx context.Context, req *pb.ListEndingTrialsRequest) (*pb.ListSubscriptionsResponse, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	userIDs, err := parseUUIDs("user_ids", req.GetUserIds())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	filter := ports.SubscriptionFilter{
+		UserIDs:      userIDs,
+		ServiceNames: req.GetServiceNames(),
+	}
+
+	pagination := ports.Pagination{
+		Page:  intOrDefault(req.GetPage(), defaultPage),
+		Limit: intOrDefault(req.GetLimit(), defaultLimit),
+	}
+
+	subscriptions, meta, err := a.service.ListEndingTrials(ctx, intOrDefault(req.GetWithinMonths(), 1), filter, pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list ending trials")
+		return nil, toStatus(err)
+	}
+
+	return &pb.ListSubscriptionsResponse{
+		Data:       convertSubscriptionsToProto(subscriptions),
+		Pagination: convertPaginationToProto(meta),
+	}, nil
+}
+
+// UpdateSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	members, err := convertMembersFromProto(req.GetMembers())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	subscription, err := a.service.UpdateSubscription(ctx, id, &ports.UpdateSubscriptionRequest{
+		ServiceName:  req.GetServiceName(),
+		Price:        convertMoneyFromProto(req.GetPrice()),
+		TaxRate:      int(req.GetTaxRate()),
+		TaxInclusive: req.TaxInclusive,
+		UserID:       userID,
+		StartDate:    req.GetStartDate(),
+		EndDate:      req.EndDate,
+		TrialEndDate: req.TrialEndDate,
+		Category:     req.GetCategory(),
+		Tags:         req.GetTags(),
+		Split:        domain.SplitRule(req.GetSplit()),
+		Members:      members,
+		Discounts:    convertDiscountsFromProto(req.GetDiscounts()),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to update subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// PatchSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) PatchSubscription(ctx context.Context, req *pb.PatchSubscriptionRequest) (*pb.Subscription, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	domainReq := &ports.PartialUpdateRequest{
+		ServiceName:  req.ServiceName,
+		EndDate:      req.EndDate,
+		TrialEndDate: req.TrialEndDate,
+		Category:     req.Category,
+	}
+	if req.Price != nil {
+		price := convertMoneyFromProto(req.GetPrice())
+		domainReq.Price = &price
+	}
+	if req.TaxRate != nil {
+		taxRate := int(req.GetTaxRate())
+		domainReq.TaxRate = &taxRate
+	}
+	domainReq.TaxInclusive = req.TaxInclusive
+	if req.Tags != nil {
+		// An empty list removes the tags, so it must not turn into nil
+		domainReq.Tags = append([]string{}, req.GetTags().GetTags()...)
+	}
+	if req.Split != nil {
+		split := domain.SplitRule(req.GetSplit())
+		domainReq.Split = &split
+	}
+	if req.Members != nil {
+		if domainReq.Members, err = convertMembersFromProto(req.GetMembers().GetMembers()); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	if req.Discounts != nil {
+		domainReq.Discounts = convertDiscountsFromProto(req.GetDiscounts().GetDiscounts())
+	}
+
+	subscription, err := a.service.PartialUpdateSubscription(ctx, id, domainReq)
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to partially update subscription")
+		return nil, toStatus(err)
+	}
+
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// DeleteSubscription implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) DeleteSubscription(ctx context.Context, req *pb.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err = a.service.DeleteSubscription(ctx, id); err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to delete subscription")
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetTotalCost implements pb.SubscriptionServiceServer.
+func (a *GRPCAdapter) GetTotalCost(ctx context.Context, req *pb.GetTotalCostRequest) (*pb.GetTotalCostResponse, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	userIDs, err := parseUUIDs("user_ids", req.GetUserIds())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	result, err := a.service.GetTotalCost(ctx, &ports.TotalCostRequest{
+		StartDate:    req.GetStartDate(),
+		EndDate:      req.GetEndDate(),
+		UserIDs:      userIDs,
+		ServiceNames: req.GetServiceNames(),
+		Categories:   req.GetCategories(),
+		Tags:         req.GetTags(),
+		GroupBy:      ports.CostGroup(req.GetGroupBy()),
+		Attribution:  ports.CostAttribution(req.GetAttribution()),
+		Currency:     domain.Currency(req.GetCurrency()),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to calculate total cost")
+		return nil, toStatus(err)
+	}
+
+	return convertTotalCostToProto(result), nil
+}
+
+func intOrDefault(value int32, def int) int {
+	if value == 0 {
+		return def
+	}
+	return int(value)
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"subscription/core/domain"
+)
+
+// toStatus converts a service error into a gRPC status error.
+// Errors that are not domain errors are reported as internal without their details.
+func toStatus(err error) error {
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
+		return status.Error(codes.Internal, domain.ErrInternal.Error())
+	}
+
+	st := status.New(getCodeFromDomainError(err, domainErr), domainErr.Message)
+
+	// Field level validation problems are attached as a BadRequest detail
+	if fieldErrs := domain.FieldErrors(err); len(fieldErrs) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(fieldErrs))
+		for i, fieldErr := range fieldErrs {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: fieldErr.Field, Description: fieldErr.Reason}
+		}
+		withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if detailsErr == nil {
+			st = withDetails
+		}
+	}
+
+	return st.Err()
+}
+
+func getCodeFromDomainError(err error, domainErr *domain.DomainError) codes.Code {
+	// An overlapping subscription is a conflict with an existing one rather than an aborted operation
+	if domain.IsOverlapError(err) {
+		return codes.AlreadyExists
+	}
+
+	switch domainErr.Code {
+	case domain.ValidationError:
+		return codes.InvalidArgument
+	case domain.UnauthorizedError:
+		return codes.Unauthenticated
+	case domain.ForbiddenError:
+		return codes.PermissionDenied
+	case domain.NotFoundError:
+		return codes.NotFound
+	case domain.ConflictError:
+		return codes.Aborted
+	case domain.UnprocessableEntityError:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// panicError wraps a recovered panic value
+func panicError(recovered interface{}) error {
+	return fmt.Errorf("panic: %v", recovered)
+}
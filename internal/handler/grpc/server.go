@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	pb "subscription/internal/api/grpc/subscription/v1"
+	"subscription/internal/auth"
+	"subscription/internal/handler"
+	"subscription/internal/logger"
+)
+
+const (
+	requestIDMetadata     = "x-request-id"
+	authorizationMetadata = "authorization"
+	apiKeyMetadata        = "x-api-key"
+	retryAfterMetadata    = "retry-after"
+)
+
+type contextKey string
+
+// RequestIDKey holds the request ID of a call
+const RequestIDKey contextKey = "request_id"
+
+// NewServer creates a gRPC server exposing the SubscriptionService, the standard
+// health service and server reflection. Only SubscriptionService calls require credentials
+// and are rate limited, by peer IP before authentication and by principal after it.
+func NewServer(service ports.SubscriptionService, authenticator *auth.Authenticator, rateLimit handler.RateLimitConfig, tracerProvider trace.TracerProvider) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tracerProvider))),
+		grpc.ChainUnaryInterceptor(
+			requestIDInterceptor,
+			loggingInterceptor,
+			recoveryInterceptor,
+			rateLimitInterceptor(handler.NewRateLimiter(rateLimit.IP, rateLimit.MaxClients), peerClientKey),
+			authInterceptor(authenticator),
+			rateLimitInterceptor(handler.NewRateLimiter(rateLimit.Default, rateLimit.MaxClients), principalClientKey),
+		),
+	)
+
+	pb.RegisterSubscriptionServiceServer(server, NewGRPCAdapter(service))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+
+	return server
+}
+
+// requestIDInterceptor takes the request ID from metadata or generates one and returns it in headers
+func requestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := firstMetadata(ctx, requestIDMetadata)
+	if requestID == "" {
+		requestID = "req-" + uuid.NewString()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+
+	return handler(context.WithValue(ctx, RequestIDKey, requestID), req)
+}
+
+// loggingInterceptor logs every call with its status code and duration
+func loggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+	event := log.Info()
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		event = log.Error()
+	case codes.OK:
+	default:
+		event = log.Warn()
+	}
+
+	event.
+		Str("method", info.FullMethod).
+		Str("code", code.String()).
+		Dur("duration", time.Since(start)).
+		Msg("gRPC request completed")
+
+	return resp, err
+}
+
+// recoveryInterceptor turns panics into internal errors
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logger.WithRequestContext(ctx, getRequestID(ctx)).Error().
+				Err(panicError(recovered)).
+				Str("method", info.FullMethod).
+				Msg("Recovered from panic")
+			err = status.Error(codes.Internal, domain.ErrInternal.Error())
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// authInterceptor authenticates SubscriptionService calls by the x-api-key or
+// authorization metadata and stores the principal in the context
+func authInterceptor(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
+	servicePrefix := "/" + pb.SubscriptionService_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+
+		var (
+			principal *ports.Principal
+			err       error
+		)
+		if key := firstMetadata(ctx, apiKeyMetadata); key != "" {
+			principal, err = authenticator.AuthenticateAPIKey(ctx, key)
+		} else {
+			token, ok := strings.CutPrefix(firstMetadata(ctx, authorizationMetadata), "Bearer ")
+			if !ok || strings.TrimSpace(token) == "" {
+				return nil, toStatus(domain.ErrUnauthorized)
+			}
+			principal, err = authenticator.AuthenticateToken(ctx, strings.TrimSpace(token))
+		}
+		if err != nil {
+			logger.WithRequestContext(ctx, getRequestID(ctx)).Warn().
+				Err(err).
+				Str("method", info.FullMethod).
+				Msg("Authentication failed")
+			return nil, toStatus(domain.ErrUnauthorized)
+		}
+
+		return handler(ports.WithPrincipal(ctx, principal), req)
+	}
+}
+
+// rateLimitInterceptor limits SubscriptionService calls per client key and
+// rejects calls over the limit with ResourceExhausted and a retry-after header
+func rateLimitInterceptor(limiter *handler.RateLimiter, clientKey func(context.Context) string) grpc.UnaryServerInterceptor {
+	servicePrefix := "/" + pb.SubscriptionService_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+
+		client := clientKey(ctx)
+		allowed, retryAfter := limiter.Allow(client)
+		if !allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadata, strconv.Itoa(retryAfter)))
+
+			logger.WithRequestContext(ctx, getRequestID(ctx)).Warn().
+				Str("client", client).
+				Str("method", info.FullMethod).
+				Msg("Rate limit exceeded")
+			return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded, retry in %d seconds", retryAfter))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// peerClientKey identifies the caller by the IP address of the connection
+func peerClientKey(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "ip:unknown"
+	}
+
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return "ip:" + addr
+}
+
+// principalClientKey identifies the caller by principal (user or API key), it must run after authInterceptor
+func principalClientKey(ctx context.Context) string {
+	if principal, ok := ports.PrincipalFromContext(ctx); ok {
+		return "principal:" + principal.TenantID.String() + ":" + principal.Subject
+	}
+	return peerClientKey(ctx)
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func getRequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(RequestIDKey).(string); ok && requestID != "" {
+		return requestID
+	}
+	return "unknown"
+}
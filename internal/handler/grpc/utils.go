@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	pb "subscription/internal/api/grpc/subscription/v1"
+)
+
+func parseUUID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(field, "must be a UUID")
+	}
+	return id, nil
+}
+
+func parseUUIDs(field string, values []string) ([]uuid.UUID, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		id, err := parseUUID(field, value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func convertMoneyToProto(money domain.Money) *pb.Money {
+	return &pb.Money{Amount: money.Amount, Currency: string(money.Currency)}
+}
+
+// convertMoneyFromProto keeps an unset price zero, so the catalog default applies
+func convertMoneyFromProto(money *pb.Money) domain.Money {
+	return domain.NewMoney(money.GetAmount(), domain.Currency(money.GetCurrency()))
+}
+
+func convertSubscriptionToProto(sub *domain.Subscription) *pb.Subscription {
+	if sub == nil {
+		return nil
+	}
+
+	return &pb.Subscription{
+		Id:                 sub.ID.String(),
+		ServiceName:        sub.ServiceName,
+		Price:              convertMoneyToProto(sub.Price),
+		TaxRate:            int32(sub.Tax.Rate),
+		TaxInclusive:       sub.Tax.Inclusive,
+		UserId:             sub.UserID.String(),
+		StartDate:          sub.StartDate,
+		EndDate:            sub.EndDate,
+		CreatedAt:          timestamppb.New(sub.CreatedAt),
+		UpdatedAt:          timestamppb.New(sub.UpdatedAt),
+		TrialEndDate:       sub.TrialEndDate,
+		Pauses:             convertPausesToProto(sub.Pauses),
+		Status:             string(sub.Status()),
+		CancelledAt:        timestampOrNil(sub.CancelledAt),
+		CancellationReason: sub.CancellationReason,
+		ServiceId:          uuidStringOrNil(sub.ServiceID),
+		Category:           sub.Category,
+		Tags:               sub.Tags,
+		Split:              string(sub.Split),
+		Members:            convertMembersToProto(sub.Members),
+		Discounts:          convertDiscountsToProto(sub.Discounts),
+	}
+}
+
+func uuidStringOrNil(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func convertStatusesFromProto(statuses []string) []domain.Status {
+	if len(statuses) == 0 {
+		return nil
+	}
+	result := make([]domain.Status, len(statuses))
+	for i, status := range statuses {
+		result[i] = domain.Status(status)
+	}
+	return result
+}
+
+func convertPausesToProto(pauses []domain.Pause) []*pb.Pause {
+	result := make([]*pb.Pause, len(pauses))
+	for i, pause := range pauses {
+		result[i] = &pb.Pause{
+			StartDate: pause.StartDate,
+			EndDate:   pause.EndDate,
+		}
+	}
+	return result
+}
+
+func convertMembersToProto(members []domain.Member) []*pb.Member {
+	result := make([]*pb.Member, len(members))
+	for i, member := range members {
+		result[i] = &pb.Member{
+			UserId: member.UserID.String(),
+			Share:  member.Share,
+			Amount: member.Amount,
+		}
+	}
+	return result
+}
+
+// convertMembersFromProto never returns nil members, an empty list of a patch ends the sharing
+func convertMembersFromProto(members []*pb.Member) ([]ports.MemberShare, error) {
+	result := make([]ports.MemberShare, len(members))
+	for i, member := range members {
+		userID, err := parseUUID("members", member.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		result[i] = ports.MemberShare{UserID: userID, Share: member.GetShare()}
+	}
+	return result, nil
+}
+
+func convertDiscountsToProto(discounts []domain.Discount) []*pb.Discount {
+	result := make([]*pb.Discount, len(discounts))
+	for i, discount := range discounts {
+		result[i] = &pb.Discount{
+			Kind:      string(discount.Kind),
+			Value:     discount.Value,
+			StartDate: discount.StartDate,
+			EndDate:   discount.EndDate,
+		}
+	}
+	return result
+}
+
+// convertDiscountsFromProto never returns nil discounts, an empty list of a patch removes them
+func convertDiscountsFromProto(discounts []*pb.Discount) []ports.DiscountRule {
+	result := make([]ports.DiscountRule, len(discounts))
+	for i, discount := range discounts {
+		result[i] = ports.DiscountRule{
+			Kind:      domain.DiscountKind(discount.GetKind()),
+			Value:     discount.GetValue(),
+			StartDate: discount.GetStartDate(),
+			EndDate:   discount.EndDate,
+		}
+	}
+	return result
+}
+
+func convertSubscriptionsToProto(subscriptions []*domain.Subscription) []*pb.Subscription {
+	result := make([]*pb.Subscription, len(subscriptions))
+	for i, sub := range subscriptions {
+		result[i] = convertSubscriptionToProto(sub)
+	}
+	return result
+}
+
+func convertPaginationToProto(meta *ports.PaginationMetadata) *pb.Pagination {
+	if meta == nil {
+		return nil
+	}
+	return &pb.Pagination{
+		Page:  int32(meta.Page),
+		Limit: int32(meta.Limit),
+		Total: int32(meta.Total),
+		Pages: int32(meta.TotalPages),
+	}
+}
+
+func convertTotalCostToProto(result *ports.TotalCostResponse) *pb.GetTotalCostResponse {
+	userIDs := make([]string, len(result.FilterCriteria.UserIDs))
+	for i, id := range result.FilterCriteria.UserIDs {
+		userIDs[i] = id.String()
+	}
+
+	return &pb.GetTotalCostResponse{
+		TotalCost: result.TotalCost,
+		NetCost:   result.NetCost,
+		Tax:       result.Tax,
+		Discount:  result.Discount,
+		Currency:  string(result.Currency),
+		Period: &pb.Period{
+			StartDate: result.Period.StartDate,
+			EndDate:   result.Period.EndDate,
+		},
+		FilterCriteria: &pb.FilterCriteria{
+			UserIds:      userIDs,
+			ServiceNames: result.FilterCriteria.ServiceNames,
+			Categories:   result.FilterCriteria.Categories,
+			Tags:         result.FilterCriteria.Tags,
+			Attribution:  string(result.FilterCriteria.Attribution),
+		},
+		Breakdown: convertBreakdownToProto(result.Breakdown),
+	}
+}
+
+func convertBreakdownToProto(breakdown []ports.CostBreakdownItem) []*pb.CostBreakdownItem {
+	result := make([]*pb.CostBreakdownItem, len(breakdown))
+	for i, item := range breakdown {
+		result[i] = &pb.CostBreakdownItem{
+			Key:       item.Key,
+			TotalCost: item.TotalCost,
+			NetCost:   item.NetCost,
+			Tax:       item.Tax,
+			Discount:  item.Discount,
+		}
+	}
+	return result
+}
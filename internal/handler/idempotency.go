@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBytes = 1 << 20 // 1 MB
+)
+
+// idempotencyMiddleware replays stored responses for repeated POST/PUT/PATCH
+// requests carrying the same Idempotency-Key header
+func idempotencyMiddleware(store ports.IdempotencyStore, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || !isIdempotentMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > maxIdempotencyKeyLength {
+				writeError(w, r, domain.NewValidationError(IdempotencyKeyHeader,
+					"must not exceed "+strconv.Itoa(maxIdempotencyKeyLength)+" characters"))
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBytes+1))
+			if err != nil {
+				writeProblem(w, r, http.StatusBadRequest, "Failed to read request body")
+				return
+			}
+			if len(body) > maxIdempotentRequestBytes {
+				writeProblem(w, r, http.StatusRequestEntityTooLarge, "Request body is too large")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			route := idempotencyRoute(r)
+			requestHash := hashRequestBody(body)
+
+			log := logger.WithRequestContext(r.Context(), getRequestID(r)).With().
+				Str("idempotency_key", key).
+				Str("route", route).
+				Logger()
+
+			existing, err := store.Reserve(r.Context(), key, route, requestHash, ttl)
+			if err != nil {
+				if errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+					writeError(w, r, err)
+					return
+				}
+				log.Error().Err(err).Msg("Failed to reserve idempotency key")
+				writeError(w, r, domain.ErrInternal)
+				return
+			}
+
+			if existing != nil {
+				switch {
+				case existing.RequestHash != requestHash:
+					log.Warn().Msg("Idempotency key reused with a different payload")
+					writeError(w, r, domain.ErrIdempotencyKeyMismatch)
+				case !existing.Completed:
+					writeError(w, r, domain.ErrIdempotencyKeyInProgress)
+				default:
+					log.Debug().Int("status", existing.StatusCode).Msg("Replaying idempotent response")
+					replayIdempotentResponse(w, existing)
+				}
+				return
+			}
+
+			recorder := &idempotencyRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				// The handler panicked or failed: allow the client to retry with the same key
+				if releaseErr := store.Release(context.WithoutCancel(r.Context()), key, route); releaseErr != nil {
+					log.Error().Err(releaseErr).Msg("Failed to release idempotency key")
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+
+			if err = store.Complete(context.WithoutCancel(r.Context()), key, route,
+				recorder.statusCode, recorder.Header().Get("Content-Type"), recorder.body.Bytes()); err != nil {
+				log.Error().Err(err).Msg("Failed to store idempotent response")
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// RunIdempotencyJanitor periodically removes expired idempotency keys until ctx is done
+func RunIdempotencyJanitor(ctx context.Context, store ports.IdempotencyStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := store.DeleteExpired(ctx)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to purge expired idempotency keys")
+				continue
+			}
+			logger.Debug().Int64("deleted", deleted).Msg("Expired idempotency keys purged")
+		}
+	}
+}
+
+// idempotencyRoute scopes keys by caller and route, so that principals cannot replay each other's responses
+func idempotencyRoute(r *http.Request) string {
+	route := r.Method + " " + r.URL.Path
+	if principal, ok := ports.PrincipalFromContext(r.Context()); ok {
+		route = principal.Subject + " " + route
+	}
+	return route
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	default:
+		return false
+	}
+}
+
+func hashRequestBody(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func replayIdempotentResponse(w http.ResponseWriter, record *ports.IdempotencyRecord) {
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+
+	if len(record.ResponseBody) > 0 {
+		if _, err := w.Write(record.ResponseBody); err != nil {
+			logger.Error().Err(err).Msg("Failed to write replayed response")
+		}
+	}
+}
+
+// idempotencyRecorder passes the response through while keeping a copy of it
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	body        bytes.Buffer
+	statusCode  int
+	wroteHeader bool
+}
+
+func (rec *idempotencyRecorder) WriteHeader(code int) {
+	if !rec.wroteHeader {
+		rec.statusCode = code
+		rec.wroteHeader = true
+		rec.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (rec *idempotencyRecorder) Write(data []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	rec.body.Write(data)
+	return rec.ResponseWriter.Write(data)
+}
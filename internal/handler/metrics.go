@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"subscription/internal/metrics"
+)
+
+// routeFinder resolves the operation serving a request
+type routeFinder interface {
+	findRoute(r *http.Request) (name, pattern string, ok bool)
+}
+
+// metricsMiddleware records request counters and latency labeled by ogen operation name
+func metricsMiddleware(routes routeFinder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			operation := "unknown"
+			if name, _, ok := routes.findRoute(r); ok {
+				operation = name
+			}
+
+			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(wrapped, r)
+
+			metrics.ObserveHTTPRequest(operation, r.Method, wrapped.statusCode, time.Since(start))
+		})
+	}
+}
@@ -2,26 +2,50 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	"net/http"
 	"strings"
 	"time"
 
-	"golang.org/x/time/rate"
+	"subscription/core/domain"
+	"subscription/core/ports"
+	api "subscription/internal/api/generated"
+	"subscription/internal/auth"
 	"subscription/internal/logger"
 )
 
-func AddMiddleware(handler http.Handler) http.Handler {
+// MiddlewareConfig configures the middleware chain built by AddMiddleware
+type MiddlewareConfig struct {
+	RateLimit      RateLimitConfig
+	IdempotencyTTL time.Duration
+	// Mounts are served next to the ogen server, e.g. the GraphQL endpoint
+	Mounts []Mount
+}
+
+func AddMiddleware(server *api.Server, authenticator *auth.Authenticator, idempotencyStore ports.IdempotencyStore, cfg MiddlewareConfig) http.Handler {
+	routes := newRouter(server, cfg.Mounts)
+
 	return requestIDMiddleware(
-		loggingMiddleware(
-			recoveryMiddleware(
-				requestIDMiddleware(
-					corsMiddleware(
-						//authMiddleware(
-						rateLimitMiddleware(
-							handler,
+		tracingMiddleware(routes)(
+			loggingMiddleware(
+				metricsMiddleware(routes)(
+					recoveryMiddleware(
+						requestIDMiddleware(
+							corsMiddleware(
+								ipRateLimitMiddleware(cfg.RateLimit)(
+									AuthMiddleware(authenticator)(
+										rateLimitMiddleware(cfg.RateLimit)(
+											adminMiddleware(
+												idempotencyMiddleware(idempotencyStore, cfg.IdempotencyTTL)(
+													routes,
+												),
+											),
+										),
+									),
+								),
+							),
 						),
-						//),
 					),
 				),
 			),
@@ -40,7 +64,7 @@ func loggingMiddleware(next http.Handler) http.Handler {
 
 		duration := time.Since(start)
 
-		logger.Info().
+		logger.WithRequestContext(r.Context(), getRequestID(r)).Info().
 			Str("method", r.Method).
 			Str("path", r.URL.Path).
 			Str("query", r.URL.RawQuery).
@@ -49,7 +73,6 @@ func loggingMiddleware(next http.Handler) http.Handler {
 			Int("status", wrapped.statusCode).
 			Dur("duration", duration).
 			Int64("duration_ms", duration.Milliseconds()).
-			Str("request_id", getRequestID(r)).
 			Msg("HTTP request")
 	})
 }
@@ -74,16 +97,7 @@ func recoveryMiddleware(next http.Handler) http.Handler {
 					return
 				}
 
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-
-				errorResponse := []byte(`{"error":"internal_error","message":"Internal Server Error"}`)
-				if _, writeErr := w.Write(errorResponse); writeErr != nil {
-					logger.Error().
-						Err(writeErr).
-						Str("request_id", getRequestID(r)).
-						Msg("Failed to write JSON error response")
-				}
+				writeError(w, r, domain.ErrInternal)
 			}
 		}()
 
@@ -94,8 +108,9 @@ func recoveryMiddleware(next http.Handler) http.Handler {
 // requestIDMiddleware adds Request ID to each request
 func requestIDMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestID := r.Header.Get("X-Request-ID")
-		if requestID == "" {
+		// The chain runs this middleware twice, the inner one keeps the ID of the outer one
+		requestID := getRequestID(r)
+		if requestID == "unknown" {
 			requestID = generateRequestID()
 		}
 
@@ -113,7 +128,8 @@ func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Request-ID, Idempotency-Key, traceparent, tracestate")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, traceparent, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy, Retry-After")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -124,52 +140,72 @@ func corsMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// authMiddleware checks authentication
-func authMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn().
-				Str("path", r.URL.Path).
-				Str("request_id", getRequestID(r)).
-				Msg("Unauthorized request")
-
-			w.WriteHeader(http.StatusUnauthorized)
-			return
-		}
+// AuthMiddleware authenticates the bearer token or API key and stores the principal in the request context
+func AuthMiddleware(authenticator *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := ports.PrincipalFromContext(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
 
-		// Проверка токена (упрощенно)
-		if !isValidToken(token) {
-			logger.Warn().
-				Str("path", r.URL.Path).
-				Str("request_id", getRequestID(r)).
-				Msg("Invalid token")
+			principal, err := authenticator.AuthenticateRequest(r)
+			if err != nil {
+				msg := "Invalid credentials"
+				if errors.Is(err, auth.ErrMissingToken) {
+					msg = "Unauthorized request"
+				}
 
-			w.WriteHeader(http.StatusUnauthorized)
-			return
-		}
+				logger.Warn().
+					Err(err).
+					Str("path", r.URL.Path).
+					Str("request_id", getRequestID(r)).
+					Msg(msg)
 
-		next.ServeHTTP(w, r)
-	})
+				w.Header().Set("WWW-Authenticate", `Bearer realm="subscription-api"`)
+				writeProblem(w, r, http.StatusUnauthorized, "authentication required")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ports.WithPrincipal(r.Context(), principal)))
+		})
+	}
 }
 
-func isValidToken(token string) bool {
-	return strings.HasPrefix(token, "Bearer ")
+// RequireRole allows only principals with the given role, it must run after AuthMiddleware
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := ports.PrincipalFromContext(r.Context())
+			if !ok {
+				writeProblem(w, r, http.StatusUnauthorized, "authentication required")
+				return
+			}
+
+			if !principal.HasRole(role) {
+				logger.Warn().
+					Str("path", r.URL.Path).
+					Str("subject", principal.Subject).
+					Str("required_role", role).
+					Str("request_id", getRequestID(r)).
+					Msg("Forbidden request")
+
+				writeProblem(w, r, http.StatusForbidden, "access to the resource is forbidden")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
-// rateLimitMiddleware limits the frequency of requests
-func rateLimitMiddleware(next http.Handler) http.Handler {
-	limiter := rate.NewLimiter(rate.Every(time.Second), 10) // 10 requests per second
+// adminMiddleware restricts the /admin/ endpoints to the admin role, it must run after AuthMiddleware
+func adminMiddleware(next http.Handler) http.Handler {
+	admin := RequireRole(ports.RoleAdmin)(next)
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !limiter.Allow() {
-			logger.Warn().
-				Str("ip", r.RemoteAddr).
-				Str("path", r.URL.Path).
-				Str("request_id", getRequestID(r)).
-				Msg("Rate limit exceeded")
-
-			w.WriteHeader(http.StatusTooManyRequests)
+		if strings.HasPrefix(r.URL.Path, "/admin/") {
+			admin.ServeHTTP(w, r)
 			return
 		}
 
@@ -201,9 +237,17 @@ func (rw *responseWriter) Write(data []byte) (int, error) {
 	return rw.ResponseWriter.Write(data)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush event streams
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Helper functions
 
 func getRequestID(r *http.Request) string {
+	if requestID, ok := r.Context().Value("request_id").(string); ok && requestID != "" {
+		return requestID
+	}
 	if requestID := r.Header.Get("X-Request-ID"); requestID != "" {
 		return requestID
 	}
@@ -8,14 +8,17 @@ import (
 	"subscription/core/ports"
 	api "subscription/internal/api/generated" // сгенерированный ogen код
 	"subscription/internal/logger"
+	"time"
 )
 
 type OgenAdapter struct {
 	service ports.SubscriptionService
+	apiKeys ports.APIKeyService
+	catalog ports.CatalogService
 }
 
-func NewOgenAdapter(service ports.SubscriptionService) *OgenAdapter {
-	return &OgenAdapter{service: service}
+func NewOgenAdapter(service ports.SubscriptionService, apiKeys ports.APIKeyService, catalog ports.CatalogService) *OgenAdapter {
+	return &OgenAdapter{service: service, apiKeys: apiKeys, catalog: catalog}
 }
 
 // Ensure interface implementation
@@ -23,7 +26,7 @@ var _ api.Handler = (*OgenAdapter)(nil)
 
 // SubscriptionsGet implements api.Handler.
 func (h *OgenAdapter) SubscriptionsGet(ctx context.Context, params api.SubscriptionsGetParams) (api.SubscriptionsGetRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	// Convert ogen params to domain filter/pagination
 	filter := convertFilterParams(params)
@@ -37,7 +40,7 @@ func (h *OgenAdapter) SubscriptionsGet(ctx context.Context, params api.Subscript
 	subscriptions, paginationMeta, err := h.service.ListSubscriptions(ctx, filter, pagination)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list server")
-		return convertSubscriptionsGetError(err), nil
+		return nil, err
 	}
 
 	// Convert to ogen response
@@ -51,27 +54,30 @@ func (h *OgenAdapter) SubscriptionsGet(ctx context.Context, params api.Subscript
 
 // SubscriptionsPost implements api.Handler.
 func (h *OgenAdapter) SubscriptionsPost(ctx context.Context, req *api.SubscriptionCreate) (api.SubscriptionsPostRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
-
-	userID, err := uuid.Parse(req.UserID.String())
-	if err != nil {
-		return convertSubscriptionsPostError(domain.ErrInvalidUUID), nil
-	}
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	// Convert ogen request to domain request
 	domainReq := &ports.CreateSubscriptionRequest{
-		ServiceName: req.ServiceName,
-		Price:       int(req.Price),
-		UserID:      userID,
-		StartDate:   req.StartDate,
-		EndDate:     getStringPtrFromOptNil(req.EndDate),
+		ServiceName:  req.ServiceName,
+		Price:        convertMoneyFromOgen(req.Price.Or(api.Money{})),
+		TaxRate:      req.TaxRate.Or(0),
+		TaxInclusive: getBoolPtrFromOpt(req.TaxInclusive),
+		UserID:       req.UserID,
+		StartDate:    req.StartDate,
+		EndDate:      getStringPtrFromOptNil(req.EndDate),
+		TrialEndDate: getStringPtrFromOptNil(req.TrialEndDate),
+		Category:     req.Category.Or(""),
+		Tags:         req.Tags,
+		Split:        domain.SplitRule(req.Split.Or("")),
+		Members:      convertMembersFromOgen(req.Members),
+		Discounts:    convertDiscountsFromOgen(req.Discounts),
 	}
 
 	// Call domain service
 	subscription, err := h.service.CreateSubscription(ctx, domainReq)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create subscription")
-		return convertSubscriptionsPostError(err), nil
+		return nil, err
 	}
 
 	// Convert domain response to ogen response
@@ -80,12 +86,12 @@ func (h *OgenAdapter) SubscriptionsPost(ctx context.Context, req *api.Subscripti
 
 // SubscriptionsIDGet implements api.Handler.
 func (h *OgenAdapter) SubscriptionsIDGet(ctx context.Context, params api.SubscriptionsIDGetParams) (api.SubscriptionsIDGetRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	subscription, err := h.service.GetSubscription(ctx, params.ID)
 	if err != nil {
 		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to get subscription")
-		return convertSubscriptionsIDGetError(err), nil
+		return nil, err
 	}
 
 	return convertSubscriptionToOgen(subscription), nil
@@ -93,20 +99,28 @@ func (h *OgenAdapter) SubscriptionsIDGet(ctx context.Context, params api.Subscri
 
 // SubscriptionsIDPut implements api.Handler.
 func (h *OgenAdapter) SubscriptionsIDPut(ctx context.Context, req *api.SubscriptionUpdate, params api.SubscriptionsIDPutParams) (api.SubscriptionsIDPutRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	domainReq := &ports.UpdateSubscriptionRequest{
-		ServiceName: req.ServiceName,
-		Price:       int(req.Price),
-		UserID:      req.UserID,
-		StartDate:   req.StartDate,
-		EndDate:     getStringPtrFromOptNil(req.EndDate),
+		ServiceName:  req.ServiceName,
+		Price:        convertMoneyFromOgen(req.Price),
+		TaxRate:      req.TaxRate.Or(0),
+		TaxInclusive: getBoolPtrFromOpt(req.TaxInclusive),
+		UserID:       req.UserID,
+		StartDate:    req.StartDate,
+		EndDate:      getStringPtrFromOptNil(req.EndDate),
+		TrialEndDate: getStringPtrFromOptNil(req.TrialEndDate),
+		Category:     req.Category.Or(""),
+		Tags:         req.Tags,
+		Split:        domain.SplitRule(req.Split.Or("")),
+		Members:      convertMembersFromOgen(req.Members),
+		Discounts:    convertDiscountsFromOgen(req.Discounts),
 	}
 
 	subscription, err := h.service.UpdateSubscription(ctx, params.ID, domainReq)
 	if err != nil {
 		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to update subscription")
-		return convertSubscriptionsIDPutError(err), nil
+		return nil, err
 	}
 
 	return convertSubscriptionToOgen(subscription), nil
@@ -114,18 +128,26 @@ func (h *OgenAdapter) SubscriptionsIDPut(ctx context.Context, req *api.Subscript
 
 // SubscriptionsIDPatch implements api.Handler.
 func (h *OgenAdapter) SubscriptionsIDPatch(ctx context.Context, req *api.SubscriptionPatch, params api.SubscriptionsIDPatchParams) (api.SubscriptionsIDPatchRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	domainReq := &ports.PartialUpdateRequest{
-		ServiceName: getStringPtrFromOpt(req.ServiceName),
-		Price:       getIntPtrFromOpt(req.Price),
-		EndDate:     getStringPtrFromOptNil(req.EndDate),
+		ServiceName:  getStringPtrFromOpt(req.ServiceName),
+		Price:        getMoneyPtrFromOpt(req.Price),
+		TaxRate:      getIntPtrFromOpt(req.TaxRate),
+		TaxInclusive: getBoolPtrFromOpt(req.TaxInclusive),
+		EndDate:      getStringPtrFromOptNil(req.EndDate),
+		TrialEndDate: getStringPtrFromOptNil(req.TrialEndDate),
+		Category:     getStringPtrFromOpt(req.Category),
+		Tags:         req.Tags,
+		Split:        getSplitRulePtrFromOpt(req.Split),
+		Members:      convertMembersFromOgen(req.Members),
+		Discounts:    convertDiscountsFromOgen(req.Discounts),
 	}
 
 	subscription, err := h.service.PartialUpdateSubscription(ctx, params.ID, domainReq)
 	if err != nil {
 		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to partially update subscription")
-		return convertSubscriptionsIDPatchError(err), nil
+		return nil, err
 	}
 
 	return convertSubscriptionToOgen(subscription), nil
@@ -133,32 +155,110 @@ func (h *OgenAdapter) SubscriptionsIDPatch(ctx context.Context, req *api.Subscri
 
 // SubscriptionsIDDelete implements api.Handler.
 func (h *OgenAdapter) SubscriptionsIDDelete(ctx context.Context, params api.SubscriptionsIDDeleteParams) (api.SubscriptionsIDDeleteRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	err := h.service.DeleteSubscription(ctx, params.ID)
 	if err != nil {
 		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to delete subscription")
-		return convertSubscriptionsIDDeleteError(err), nil
+		return nil, err
 	}
 
 	return &api.SubscriptionsIDDeleteNoContent{}, nil
 }
 
+// SubscriptionsIDPausePost implements api.Handler.
+func (h *OgenAdapter) SubscriptionsIDPausePost(ctx context.Context, req *api.SubscriptionPauseRequest, params api.SubscriptionsIDPausePostParams) (api.SubscriptionsIDPausePostRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	subscription, err := h.service.PauseSubscription(ctx, params.ID, &ports.PauseSubscriptionRequest{
+		StartDate: req.StartDate.Or(""),
+		EndDate:   getStringPtrFromOpt(req.EndDate),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to pause subscription")
+		return nil, err
+	}
+
+	return convertSubscriptionToOgen(subscription), nil
+}
+
+// SubscriptionsIDResumePost implements api.Handler.
+func (h *OgenAdapter) SubscriptionsIDResumePost(ctx context.Context, req *api.SubscriptionResumeRequest, params api.SubscriptionsIDResumePostParams) (api.SubscriptionsIDResumePostRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	subscription, err := h.service.ResumeSubscription(ctx, params.ID, &ports.ResumeSubscriptionRequest{
+		Date: req.Date.Or(""),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to resume subscription")
+		return nil, err
+	}
+
+	return convertSubscriptionToOgen(subscription), nil
+}
+
+// SubscriptionsIDCancelPost implements api.Handler.
+func (h *OgenAdapter) SubscriptionsIDCancelPost(ctx context.Context, req *api.SubscriptionCancelRequest, params api.SubscriptionsIDCancelPostParams) (api.SubscriptionsIDCancelPostRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	subscription, err := h.service.CancelSubscription(ctx, params.ID, &ports.CancelSubscriptionRequest{
+		EndDate: req.EndDate.Or(""),
+		Reason:  getStringPtrFromOpt(req.Reason),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", params.ID.String()).Msg("Failed to cancel subscription")
+		return nil, err
+	}
+
+	return convertSubscriptionToOgen(subscription), nil
+}
+
+// SubscriptionsTrialsEndingGet implements api.Handler.
+func (h *OgenAdapter) SubscriptionsTrialsEndingGet(ctx context.Context, params api.SubscriptionsTrialsEndingGetParams) (api.SubscriptionsTrialsEndingGetRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	filter := ports.SubscriptionFilter{
+		UserIDs:      params.UserIds,
+		ServiceNames: params.ServiceNames,
+	}
+
+	pagination := ports.Pagination{
+		Page:  getIntOrDefault(params.Page.Get, 1),
+		Limit: getIntOrDefault(params.Limit.Get, 20),
+	}
+
+	subscriptions, paginationMeta, err := h.service.ListEndingTrials(ctx, getIntOrDefault(params.WithinMonths.Get, 1), filter, pagination)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list ending trials")
+		return nil, err
+	}
+
+	return &api.SubscriptionsTrialsEndingGetOK{
+		Data:       convertSubscriptionsToOgen(subscriptions),
+		Pagination: convertPaginationToOgen(paginationMeta),
+	}, nil
+}
+
 // SubscriptionsSummaryTotalCostGet implements api.Handler.
 func (h *OgenAdapter) SubscriptionsSummaryTotalCostGet(ctx context.Context, params api.SubscriptionsSummaryTotalCostGetParams) (api.SubscriptionsSummaryTotalCostGetRes, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	domainReq := &ports.TotalCostRequest{
 		StartDate:    params.StartDate,
 		EndDate:      params.EndDate,
 		UserIDs:      params.UserIds,
 		ServiceNames: params.ServiceNames,
+		Categories:   params.Categories,
+		Tags:         params.Tags,
+		GroupBy:      ports.CostGroup(params.GroupBy.Or("")),
+		Attribution:  ports.CostAttribution(params.Attribution.Or("")),
+		Currency:     domain.Currency(params.Currency.Or("")),
 	}
 
 	result, err := h.service.GetTotalCost(ctx, domainReq)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to calculate total cost")
-		return convertSubscriptionsSummaryTotalCostGetError(err), nil
+		return nil, err
 	}
 
 	period := api.SubscriptionsSummaryTotalCostGetOKPeriod{}
@@ -177,14 +277,22 @@ func (h *OgenAdapter) SubscriptionsSummaryTotalCostGet(ctx context.Context, para
 
 	filter.SetUserIds(stringIDs)
 	filter.SetServiceNames(result.FilterCriteria.ServiceNames)
+	filter.SetCategories(result.FilterCriteria.Categories)
+	filter.SetTags(result.FilterCriteria.Tags)
+	filter.SetAttribution(api.NewOptString(string(result.FilterCriteria.Attribution)))
 
 	optFilter := api.OptSubscriptionsSummaryTotalCostGetOKFilterCriteria{}
 	optFilter.SetTo(filter)
 
 	response := &api.SubscriptionsSummaryTotalCostGetOK{
-		TotalCost:      api.NewOptInt(result.TotalCost),
+		TotalCost:      api.NewOptInt64(result.TotalCost),
+		NetCost:        api.NewOptInt64(result.NetCost),
+		Tax:            api.NewOptInt64(result.Tax),
+		Discount:       api.NewOptInt64(result.Discount),
+		Currency:       api.NewOptString(string(result.Currency)),
 		Period:         optPeriod,
 		FilterCriteria: optFilter,
+		Breakdown:      convertBreakdownToOgen(result.Breakdown),
 	}
 
 	return response, nil
@@ -206,11 +314,41 @@ func getStringPtrFromOpt(opt api.OptString) *string {
 	return &opt.Value
 }
 
-func getIntPtrFromOpt(opt api.OptInt32) *int {
+func getSplitRulePtrFromOpt(opt api.OptSplitRule) *domain.SplitRule {
+	if !opt.Set {
+		return nil
+	}
+	rule := domain.SplitRule(opt.Value)
+	return &rule
+}
+
+func getMoneyPtrFromOpt(opt api.OptMoney) *domain.Money {
+	if !opt.Set {
+		return nil
+	}
+	value := convertMoneyFromOgen(opt.Value)
+	return &value
+}
+
+func getIntPtrFromOpt(opt api.OptInt) *int {
+	if !opt.Set {
+		return nil
+	}
+	return &opt.Value
+}
+
+func getBoolPtrFromOpt(opt api.OptBool) *bool {
 	if !opt.Set {
 		return nil
 	}
-	value := int(opt.Value)
+	return &opt.Value
+}
+
+func getTimePtrFromOpt(opt api.OptDateTime) *time.Time {
+	if !opt.Set {
+		return nil
+	}
+	value := opt.Value
 	return &value
 }
 
@@ -243,6 +381,11 @@ func (k contextKey) String() string {
 }
 
 func getRequestID(ctx context.Context) string {
+	// The HTTP middleware stores the request ID under a plain string key
+	if value, ok := ctx.Value(string(RequestIDKey)).(string); ok && value != "" {
+		return value
+	}
+
 	keys := []contextKey{RequestIDKey, XRequestIDKey}
 	for _, key := range keys {
 		if value, ok := ctx.Value(key).(string); ok && value != "" {
@@ -0,0 +1,125 @@
+package ogen
+
+import (
+	"context"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	api "subscription/internal/api/generated"
+	"subscription/internal/logger"
+)
+
+// AdminAPIKeysPost implements api.Handler.
+func (h *OgenAdapter) AdminAPIKeysPost(ctx context.Context, req *api.APIKeyCreate) (api.AdminAPIKeysPostRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	domainReq := &ports.CreateAPIKeyRequest{
+		Name:      req.Name,
+		Scopes:    convertAPIKeyScopesFromOgen(req.Scopes),
+		ExpiresAt: getTimePtrFromOpt(req.ExpiresAt),
+	}
+
+	created, err := h.apiKeys.CreateAPIKey(ctx, domainReq)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create API key")
+		return nil, err
+	}
+
+	return &api.APIKeyCreated{
+		APIKey: convertAPIKeyToOgen(created.Key),
+		Key:    created.Secret,
+	}, nil
+}
+
+// AdminAPIKeysGet implements api.Handler.
+func (h *OgenAdapter) AdminAPIKeysGet(ctx context.Context) (*api.AdminAPIKeysGetOK, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	keys, err := h.apiKeys.ListAPIKeys(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list API keys")
+		return nil, err
+	}
+
+	data := make([]api.APIKey, len(keys))
+	for i, key := range keys {
+		data[i] = convertAPIKeyToOgen(key)
+	}
+
+	return &api.AdminAPIKeysGetOK{Data: data}, nil
+}
+
+// AdminAPIKeysIDGet implements api.Handler.
+func (h *OgenAdapter) AdminAPIKeysIDGet(ctx context.Context, params api.AdminAPIKeysIDGetParams) (api.AdminAPIKeysIDGetRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	key, err := h.apiKeys.GetAPIKey(ctx, params.ID)
+	if err != nil {
+		log.Error().Err(err).Str("api_key_id", params.ID.String()).Msg("Failed to get API key")
+		return nil, err
+	}
+
+	result := convertAPIKeyToOgen(key)
+	return &result, nil
+}
+
+// AdminAPIKeysIDPatch implements api.Handler.
+func (h *OgenAdapter) AdminAPIKeysIDPatch(ctx context.Context, req *api.APIKeyPatch, params api.AdminAPIKeysIDPatchParams) (api.AdminAPIKeysIDPatchRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	domainReq := &ports.UpdateAPIKeyRequest{
+		Name:   getStringPtrFromOpt(req.Name),
+		Scopes: convertAPIKeyScopesFromOgen(req.Scopes),
+	}
+
+	key, err := h.apiKeys.UpdateAPIKey(ctx, params.ID, domainReq)
+	if err != nil {
+		log.Error().Err(err).Str("api_key_id", params.ID.String()).Msg("Failed to update API key")
+		return nil, err
+	}
+
+	result := convertAPIKeyToOgen(key)
+	return &result, nil
+}
+
+// AdminAPIKeysIDDelete implements api.Handler.
+func (h *OgenAdapter) AdminAPIKeysIDDelete(ctx context.Context, params api.AdminAPIKeysIDDeleteParams) (api.AdminAPIKeysIDDeleteRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	if err := h.apiKeys.RevokeAPIKey(ctx, params.ID); err != nil {
+		log.Error().Err(err).Str("api_key_id", params.ID.String()).Msg("Failed to revoke API key")
+		return nil, err
+	}
+
+	return &api.AdminAPIKeysIDDeleteNoContent{}, nil
+}
+
+func convertAPIKeyToOgen(key *domain.APIKey) api.APIKey {
+	scopes := make([]api.APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = api.APIKeyScope(scope)
+	}
+
+	return api.APIKey{
+		ID:         api.NewOptUUID(key.ID),
+		Name:       api.NewOptString(key.Name),
+		Prefix:     api.NewOptString(key.Prefix),
+		Scopes:     scopes,
+		CreatedAt:  api.NewOptDateTime(key.CreatedAt),
+		ExpiresAt:  newOptNilDateTimePtr(key.ExpiresAt),
+		LastUsedAt: newOptNilDateTimePtr(key.LastUsedAt),
+		RevokedAt:  newOptNilDateTimePtr(key.RevokedAt),
+	}
+}
+
+func convertAPIKeyScopesFromOgen(scopes []api.APIKeyScope) []domain.APIKeyScope {
+	if scopes == nil {
+		return nil
+	}
+
+	result := make([]domain.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = domain.APIKeyScope(scope)
+	}
+	return result
+}
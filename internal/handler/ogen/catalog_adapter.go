@@ -0,0 +1,121 @@
+package ogen
+
+import (
+	"context"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	api "subscription/internal/api/generated"
+	"subscription/internal/logger"
+)
+
+// ServicesPost implements api.Handler.
+func (h *OgenAdapter) ServicesPost(ctx context.Context, req *api.ServiceCreate) (api.ServicesPostRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	domainReq := &ports.CreateServiceRequest{
+		Name:         req.Name,
+		Aliases:      req.Aliases,
+		Category:     req.Category.Or(""),
+		DefaultPrice: getMoneyPtrFromOpt(req.DefaultPrice),
+		BillingCycle: domain.BillingCycle(req.BillingCycle.Or("")),
+	}
+
+	service, err := h.catalog.CreateService(ctx, domainReq)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create service")
+		return nil, err
+	}
+
+	result := convertServiceToOgen(service)
+	return &result, nil
+}
+
+// ServicesGet implements api.Handler.
+func (h *OgenAdapter) ServicesGet(ctx context.Context, params api.ServicesGetParams) (*api.ServicesGetOK, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	services, err := h.catalog.ListServices(ctx, params.Category.Or(""))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list services")
+		return nil, err
+	}
+
+	data := make([]api.Service, len(services))
+	for i, service := range services {
+		data[i] = convertServiceToOgen(service)
+	}
+
+	return &api.ServicesGetOK{Data: data}, nil
+}
+
+// ServicesIDGet implements api.Handler.
+func (h *OgenAdapter) ServicesIDGet(ctx context.Context, params api.ServicesIDGetParams) (api.ServicesIDGetRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	service, err := h.catalog.GetService(ctx, params.ID)
+	if err != nil {
+		log.Error().Err(err).Str("service_id", params.ID.String()).Msg("Failed to get service")
+		return nil, err
+	}
+
+	result := convertServiceToOgen(service)
+	return &result, nil
+}
+
+// ServicesIDPatch implements api.Handler.
+func (h *OgenAdapter) ServicesIDPatch(ctx context.Context, req *api.ServicePatch, params api.ServicesIDPatchParams) (api.ServicesIDPatchRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	domainReq := &ports.UpdateServiceRequest{
+		Name:               getStringPtrFromOpt(req.Name),
+		Aliases:            req.Aliases,
+		Category:           getStringPtrFromOpt(req.Category),
+		RemoveDefaultPrice: req.DefaultPrice.IsNull(),
+	}
+	if price, ok := req.DefaultPrice.Get(); ok {
+		value := convertMoneyFromOgen(price)
+		domainReq.DefaultPrice = &value
+	}
+	if cycle, ok := req.BillingCycle.Get(); ok {
+		value := domain.BillingCycle(cycle)
+		domainReq.BillingCycle = &value
+	}
+
+	service, err := h.catalog.UpdateService(ctx, params.ID, domainReq)
+	if err != nil {
+		log.Error().Err(err).Str("service_id", params.ID.String()).Msg("Failed to update service")
+		return nil, err
+	}
+
+	result := convertServiceToOgen(service)
+	return &result, nil
+}
+
+// ServicesIDDelete implements api.Handler.
+func (h *OgenAdapter) ServicesIDDelete(ctx context.Context, params api.ServicesIDDeleteParams) (api.ServicesIDDeleteRes, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	if err := h.catalog.DeleteService(ctx, params.ID); err != nil {
+		log.Error().Err(err).Str("service_id", params.ID.String()).Msg("Failed to delete service")
+		return nil, err
+	}
+
+	return &api.ServicesIDDeleteNoContent{}, nil
+}
+
+func convertServiceToOgen(service *domain.Service) api.Service {
+	result := api.Service{
+		ID:           api.NewOptUUID(service.ID),
+		Name:         api.NewOptString(service.Name),
+		Aliases:      append([]string{}, service.Aliases...),
+		Category:     api.NewOptString(service.Category),
+		BillingCycle: api.NewOptBillingCycle(api.BillingCycle(service.BillingCycle)),
+		CreatedAt:    api.NewOptDateTime(service.CreatedAt),
+		UpdatedAt:    api.NewOptDateTime(service.UpdatedAt),
+	}
+	if service.DefaultPrice != nil {
+		result.DefaultPrice = api.NewOptNilMoney(convertMoneyToOgen(*service.DefaultPrice))
+	}
+	return result
+}
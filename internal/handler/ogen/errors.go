@@ -3,111 +3,90 @@ package ogen
 import (
 	"context"
 	"errors"
+	"net/http"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/validate"
+
 	"subscription/core/domain"
 	api "subscription/internal/api/generated"
-	"time"
+	"subscription/internal/handler/problem"
 )
 
-// Error conversion functions for each operation
-
-func convertSubscriptionsPostError(err error) *api.SubscriptionsPostBadRequest {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsPostBadRequest)(&errorResponse)
-}
-
-func convertSubscriptionsIDGetError(err error) *api.SubscriptionsIDGetNotFound {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsIDGetNotFound)(&errorResponse)
-}
+// NewError implements api.Handler.
+func (h *OgenAdapter) NewError(ctx context.Context, err error) *api.ProblemStatusCode {
+	var securityErr *ogenerrors.SecurityError
+	if errors.As(err, &securityErr) {
+		// Do not leak token validation details
+		err = domain.ErrUnauthorized
+	}
 
-func convertSubscriptionsGetError(err error) *api.SubscriptionsGetBadRequest {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsGetBadRequest)(&errorResponse)
+	p := problem.FromError(ctx, err)
+	return &api.ProblemStatusCode{
+		StatusCode: int(p.Status),
+		Response:   p,
+	}
 }
 
-func convertSubscriptionsIDPutError(err error) *api.SubscriptionsIDPutBadRequest {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsIDPutBadRequest)(&errorResponse)
-}
+// ErrorHandler reports the errors ogen handles before an operation runs, e.g. undecodable requests,
+// as problem details. The fields of the request that failed to decode are listed in errors.
+func ErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	status := ogenerrors.ErrorCode(err)
+	if status != http.StatusBadRequest {
+		detail := http.StatusText(status)
+		if status == http.StatusInternalServerError {
+			detail = domain.ErrInternal.Error()
+		}
+		problem.Write(w, r, problem.New(ctx, status, detail))
+		return
+	}
 
-func convertSubscriptionsIDPatchError(err error) *api.SubscriptionsIDPatchBadRequest {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsIDPatchBadRequest)(&errorResponse)
+	p := problem.New(ctx, status, "Request could not be decoded")
+	p.Errors = problem.FieldErrors(decodeFieldErrors(err))
+	problem.Write(w, r, p)
 }
 
-func convertSubscriptionsIDDeleteError(err error) *api.SubscriptionsIDDeleteNotFound {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsIDDeleteNotFound)(&errorResponse)
+// NotFound reports requests for unknown paths as problem details
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	problem.Write(w, r, problem.New(r.Context(), http.StatusNotFound, "no operation is served at this path"))
 }
 
-func convertSubscriptionsSummaryTotalCostGetError(err error) *api.SubscriptionsSummaryTotalCostGetBadRequest {
-	errorResponse := createErrorResponse(err)
-	return (*api.SubscriptionsSummaryTotalCostGetBadRequest)(&errorResponse)
+// MethodNotAllowed reports requests with unsupported methods as problem details
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request, allowed string) {
+	w.Header().Set("Allow", allowed)
+	problem.Write(w, r, problem.New(r.Context(), http.StatusMethodNotAllowed, "allowed methods: "+allowed))
 }
 
-// Helper functions for creating error responses
-
-func createErrorResponse(err error) api.Error {
-	statusCode := getStatusCodeFromDomainError(err)
-	errorCode := getErrorCode(err)
-
-	return api.Error{
-		Error:     errorCode,
-		Message:   getErrorMessage(err),
-		Code:      api.NewOptInt32(int32(statusCode)),
-		Timestamp: api.NewOptDateTime(time.Now()),
-		Details:   api.OptErrorDetails{},
+// decodeFieldErrors names the parameters or body fields a decoding error was caused by
+func decodeFieldErrors(err error) []domain.FieldError {
+	var paramErr *ogenerrors.DecodeParamError
+	if errors.As(err, &paramErr) {
+		return []domain.FieldError{{Field: paramErr.Name, Reason: reason(paramErr.Err)}}
 	}
-}
 
-func getStatusCodeFromDomainError(err error) int {
-	switch {
-	case errors.Is(err, domain.ErrSubscriptionNotFound):
-		return 404
-	case errors.Is(err, domain.ErrInvalidDateformat),
-		errors.Is(err, domain.ErrInvalidUUID),
-		errors.Is(err, domain.ErrInvalidPrice),
-		errors.Is(err, domain.ErrStartDateAfterEndDate),
-		errors.Is(err, domain.ErrInvalidDateRange):
-		return 400
-	case errors.Is(err, domain.ErrDuplicateSubscription):
-		return 409
-	default:
-		return 500
+	var validateErr *validate.Error
+	if errors.As(err, &validateErr) {
+		fieldErrs := make([]domain.FieldError, len(validateErr.Fields))
+		for i, field := range validateErr.Fields {
+			fieldErrs[i] = domain.FieldError{Field: field.Name, Reason: reason(field.Error)}
+		}
+		return fieldErrs
 	}
-}
 
-func getErrorCode(err error) string {
-	switch {
-	case errors.Is(err, domain.ErrSubscriptionNotFound):
-		return "not_found"
-	case errors.Is(err, domain.ErrInvalidDateformat):
-		return "invalid_date_format"
-	case errors.Is(err, domain.ErrInvalidUUID):
-		return "invalid_uuid"
-	case errors.Is(err, domain.ErrInvalidPrice):
-		return "invalid_price"
-	case errors.Is(err, domain.ErrStartDateAfterEndDate):
-		return "invalid_date_range"
-	case errors.Is(err, domain.ErrInvalidDateRange):
-		return "invalid_date_range"
-	case errors.Is(err, domain.ErrDuplicateSubscription):
-		return "duplicate_subscription"
-	default:
-		return "internal_error"
-	}
+	return []domain.FieldError{{Field: "body", Reason: reason(err)}}
 }
 
-func getErrorMessage(err error) string {
-	// Для стандартных ошибок возвращаем их текст
-	// Для кастомных можно добавать дополнительную информацию
-	return err.Error()
-}
+func reason(err error) string {
+	if errors.Is(err, validate.ErrFieldRequired) {
+		return "is required"
+	}
+	if errors.Is(err, validate.ErrBodyRequired) {
+		return "request body is required"
+	}
 
-// NewError implements api.Handler.
-func (h *OgenAdapter) NewError(ctx context.Context, err error) *api.ErrorStatusCode {
-	return &api.ErrorStatusCode{
-		StatusCode: getStatusCodeFromDomainError(err),
-		Response:   createErrorResponse(err),
+	// Decoding errors are wrapped once per level, the innermost error describes the value
+	for next := errors.Unwrap(err); next != nil; next = errors.Unwrap(err) {
+		err = next
 	}
+	return err.Error()
 }
@@ -0,0 +1,61 @@
+package ogen
+
+import (
+	"context"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	api "subscription/internal/api/generated"
+	"subscription/internal/auth"
+	"subscription/internal/logger"
+)
+
+// SecurityHandler validates credentials of ogen operations
+type SecurityHandler struct {
+	authenticator *auth.Authenticator
+}
+
+func NewSecurityHandler(authenticator *auth.Authenticator) *SecurityHandler {
+	return &SecurityHandler{authenticator: authenticator}
+}
+
+// Ensure interface implementation
+var _ api.SecurityHandler = (*SecurityHandler)(nil)
+
+// HandleBearerAuth implements api.SecurityHandler.
+func (h *SecurityHandler) HandleBearerAuth(ctx context.Context, operationName api.OperationName, t api.BearerAuth) (context.Context, error) {
+	// The token has already been validated by the HTTP auth middleware
+	if _, ok := ports.PrincipalFromContext(ctx); ok {
+		return ctx, nil
+	}
+
+	principal, err := h.authenticator.AuthenticateToken(ctx, t.Token)
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Warn().
+			Err(err).
+			Str("operation", operationName).
+			Msg("Invalid bearer token")
+		return nil, domain.ErrUnauthorized
+	}
+
+	return ports.WithPrincipal(ctx, principal), nil
+}
+
+// HandleApiKeyAuth implements api.SecurityHandler.
+func (h *SecurityHandler) HandleApiKeyAuth(ctx context.Context, operationName api.OperationName, t api.ApiKeyAuth) (context.Context, error) {
+	// The key has already been validated by the HTTP auth middleware
+	if _, ok := ports.PrincipalFromContext(ctx); ok {
+		return ctx, nil
+	}
+
+	principal, err := h.authenticator.AuthenticateAPIKey(ctx, t.APIKey)
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Warn().
+			Err(err).
+			Str("operation", operationName).
+			Msg("Invalid API key")
+		return nil, domain.ErrUnauthorized
+	}
+
+	return ports.WithPrincipal(ctx, principal), nil
+}
@@ -1,6 +1,10 @@
 package ogen
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	"subscription/core/domain"
 	"subscription/core/ports"
 	api "subscription/internal/api/generated"
@@ -12,7 +16,29 @@ func convertFilterParams(params api.SubscriptionsGetParams) ports.SubscriptionFi
 		ServiceNames:  params.ServiceNames,
 		StartDateFrom: getStringPtrFromOpt(params.StartDateFrom),
 		StartDateTo:   getStringPtrFromOpt(params.StartDateTo),
+		Statuses:      convertStatusesFromOgen(params.Status),
+		Categories:    params.Categories,
+		Tags:          params.Tags,
+	}
+}
+
+func convertStatusesFromOgen(statuses []api.SubscriptionStatus) []domain.Status {
+	if len(statuses) == 0 {
+		return nil
 	}
+	result := make([]domain.Status, len(statuses))
+	for i, status := range statuses {
+		result[i] = domain.Status(status)
+	}
+	return result
+}
+
+func convertMoneyToOgen(money domain.Money) api.Money {
+	return api.Money{Amount: money.Amount, Currency: string(money.Currency)}
+}
+
+func convertMoneyFromOgen(money api.Money) domain.Money {
+	return domain.NewMoney(money.Amount, domain.Currency(money.Currency))
 }
 
 func convertSubscriptionToOgen(sub *domain.Subscription) *api.Subscription {
@@ -21,14 +47,27 @@ func convertSubscriptionToOgen(sub *domain.Subscription) *api.Subscription {
 	}
 
 	return &api.Subscription{
-		ID:          api.NewOptUUID(sub.ID),
-		ServiceName: api.NewOptString(sub.ServiceName),
-		Price:       api.NewOptInt32(int32(sub.Price)),
-		UserID:      api.NewOptUUID(sub.UserID),
-		StartDate:   api.NewOptString(sub.StartDate),
-		EndDate:     newOptNilStringPtr(sub.EndDate),
-		CreatedAt:   api.NewOptDateTime(sub.CreatedAt),
-		UpdatedAt:   api.NewOptDateTime(sub.UpdatedAt),
+		ID:                 api.NewOptUUID(sub.ID),
+		ServiceID:          newOptNilUUIDPtr(sub.ServiceID),
+		ServiceName:        api.NewOptString(sub.ServiceName),
+		Price:              api.NewOptMoney(convertMoneyToOgen(sub.Price)),
+		TaxRate:            api.NewOptInt(sub.Tax.Rate),
+		TaxInclusive:       api.NewOptBool(sub.Tax.Inclusive),
+		UserID:             api.NewOptUUID(sub.UserID),
+		StartDate:          api.NewOptString(sub.StartDate),
+		EndDate:            newOptNilStringPtr(sub.EndDate),
+		TrialEndDate:       newOptNilStringPtr(sub.TrialEndDate),
+		Pauses:             convertPausesToOgen(sub.Pauses),
+		Status:             api.NewOptSubscriptionStatus(api.SubscriptionStatus(sub.Status())),
+		CancelledAt:        newOptNilDateTimePtr(sub.CancelledAt),
+		CancellationReason: newOptNilStringPtr(sub.CancellationReason),
+		Category:           api.NewOptString(sub.Category),
+		Tags:               sub.Tags,
+		Split:              newOptSplitRule(sub.Split),
+		Members:            convertMembersToOgen(sub.Members),
+		Discounts:          convertDiscountsToOgen(sub.Discounts),
+		CreatedAt:          api.NewOptDateTime(sub.CreatedAt),
+		UpdatedAt:          api.NewOptDateTime(sub.UpdatedAt),
 	}
 }
 
@@ -36,14 +75,112 @@ func convertSubscriptionsToOgen(subscriptions []*domain.Subscription) []api.Subs
 	result := make([]api.Subscription, len(subscriptions))
 	for i, sub := range subscriptions {
 		result[i] = api.Subscription{
-			ID:          api.NewOptUUID(sub.ID),
-			ServiceName: api.NewOptString(sub.ServiceName),
-			Price:       api.NewOptInt32(int32(sub.Price)),
-			UserID:      api.NewOptUUID(sub.UserID),
-			StartDate:   api.NewOptString(sub.StartDate),
-			EndDate:     newOptNilStringPtr(sub.EndDate),
-			CreatedAt:   api.NewOptDateTime(sub.CreatedAt),
-			UpdatedAt:   api.NewOptDateTime(sub.UpdatedAt),
+			ID:                 api.NewOptUUID(sub.ID),
+			ServiceID:          newOptNilUUIDPtr(sub.ServiceID),
+			ServiceName:        api.NewOptString(sub.ServiceName),
+			Price:              api.NewOptMoney(convertMoneyToOgen(sub.Price)),
+			TaxRate:            api.NewOptInt(sub.Tax.Rate),
+			TaxInclusive:       api.NewOptBool(sub.Tax.Inclusive),
+			UserID:             api.NewOptUUID(sub.UserID),
+			StartDate:          api.NewOptString(sub.StartDate),
+			EndDate:            newOptNilStringPtr(sub.EndDate),
+			TrialEndDate:       newOptNilStringPtr(sub.TrialEndDate),
+			Pauses:             convertPausesToOgen(sub.Pauses),
+			Status:             api.NewOptSubscriptionStatus(api.SubscriptionStatus(sub.Status())),
+			CancelledAt:        newOptNilDateTimePtr(sub.CancelledAt),
+			CancellationReason: newOptNilStringPtr(sub.CancellationReason),
+			Category:           api.NewOptString(sub.Category),
+			Tags:               sub.Tags,
+			Split:              newOptSplitRule(sub.Split),
+			Members:            convertMembersToOgen(sub.Members),
+			Discounts:          convertDiscountsToOgen(sub.Discounts),
+			CreatedAt:          api.NewOptDateTime(sub.CreatedAt),
+			UpdatedAt:          api.NewOptDateTime(sub.UpdatedAt),
+		}
+	}
+	return result
+}
+
+func convertPausesToOgen(pauses []domain.Pause) []api.SubscriptionPause {
+	result := make([]api.SubscriptionPause, len(pauses))
+	for i, pause := range pauses {
+		result[i] = api.SubscriptionPause{
+			StartDate: pause.StartDate,
+			EndDate:   newOptNilStringPtr(pause.EndDate),
+		}
+	}
+	return result
+}
+
+func convertMembersToOgen(members []domain.Member) []api.SubscriptionMember {
+	result := make([]api.SubscriptionMember, len(members))
+	for i, member := range members {
+		result[i] = api.SubscriptionMember{
+			UserID: member.UserID,
+			Share:  member.Share,
+			Amount: member.Amount,
+		}
+	}
+	return result
+}
+
+// convertMembersFromOgen keeps nil members nil, a patch leaves the members unchanged then
+func convertMembersFromOgen(members []api.SubscriptionMemberInput) []ports.MemberShare {
+	if members == nil {
+		return nil
+	}
+	result := make([]ports.MemberShare, len(members))
+	for i, member := range members {
+		result[i] = ports.MemberShare{UserID: member.UserID, Share: member.Share.Or(0)}
+	}
+	return result
+}
+
+func convertDiscountsToOgen(discounts []domain.Discount) []api.SubscriptionDiscount {
+	result := make([]api.SubscriptionDiscount, len(discounts))
+	for i, discount := range discounts {
+		result[i] = api.SubscriptionDiscount{
+			Kind:      api.SubscriptionDiscountKind(discount.Kind),
+			Value:     discount.Value,
+			StartDate: discount.StartDate,
+			EndDate:   newOptNilStringPtr(discount.EndDate),
+		}
+	}
+	return result
+}
+
+// convertDiscountsFromOgen keeps nil discounts nil, a patch leaves the discounts unchanged then
+func convertDiscountsFromOgen(discounts []api.SubscriptionDiscount) []ports.DiscountRule {
+	if discounts == nil {
+		return nil
+	}
+	result := make([]ports.DiscountRule, len(discounts))
+	for i, discount := range discounts {
+		result[i] = ports.DiscountRule{
+			Kind:      domain.DiscountKind(discount.Kind),
+			Value:     discount.Value,
+			StartDate: discount.StartDate,
+		}
+		if discount.EndDate.Set && !discount.EndDate.Null {
+			endDate := discount.EndDate.Value
+			result[i].EndDate = &endDate
+		}
+	}
+	return result
+}
+
+func convertBreakdownToOgen(breakdown []ports.CostBreakdownItem) []api.CostBreakdownItem {
+	if breakdown == nil {
+		return nil
+	}
+	result := make([]api.CostBreakdownItem, len(breakdown))
+	for i, item := range breakdown {
+		result[i] = api.CostBreakdownItem{
+			Key:       item.Key,
+			TotalCost: item.TotalCost,
+			NetCost:   item.NetCost,
+			Tax:       item.Tax,
+			Discount:  item.Discount,
 		}
 	}
 	return result
@@ -69,3 +206,24 @@ func newOptNilStringPtr(v *string) api.OptNilString {
 	}
 	return api.NewOptNilString(*v)
 }
+
+func newOptSplitRule(rule domain.SplitRule) api.OptSplitRule {
+	if rule == "" {
+		return api.OptSplitRule{}
+	}
+	return api.NewOptSplitRule(api.SplitRule(rule))
+}
+
+func newOptNilUUIDPtr(v *uuid.UUID) api.OptNilUUID {
+	if v == nil {
+		return api.OptNilUUID{}
+	}
+	return api.NewOptNilUUID(*v)
+}
+
+func newOptNilDateTimePtr(v *time.Time) api.OptNilDateTime {
+	if v == nil {
+		return api.OptNilDateTime{}
+	}
+	return api.NewOptNilDateTime(*v)
+}
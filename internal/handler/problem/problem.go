@@ -0,0 +1,117 @@
+// Package problem renders errors as RFC 7807 problem details
+package problem
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"subscription/core/domain"
+	api "subscription/internal/api/generated"
+	"subscription/internal/logger"
+)
+
+// ContentType is the media type of problem details
+const ContentType = "application/problem+json"
+
+// requestIDKey is the context key the HTTP middleware stores the request ID under
+const requestIDKey = "request_id"
+
+// kind is a documented kind of problem, its type URI is relative to the API root
+type kind struct {
+	typeURI string
+	title   string
+}
+
+var (
+	kinds = map[int]kind{
+		http.StatusBadRequest:            {"/problems/validation-error", "Invalid request"},
+		http.StatusUnauthorized:          {"/problems/unauthorized", "Authentication required"},
+		http.StatusForbidden:             {"/problems/forbidden", "Forbidden"},
+		http.StatusNotFound:              {"/problems/not-found", "Resource not found"},
+		http.StatusMethodNotAllowed:      {"/problems/method-not-allowed", "Method not allowed"},
+		http.StatusConflict:              {"/problems/conflict", "Conflict"},
+		http.StatusRequestEntityTooLarge: {"/problems/payload-too-large", "Payload too large"},
+		http.StatusUnsupportedMediaType:  {"/problems/unsupported-media-type", "Unsupported media type"},
+		http.StatusUnprocessableEntity:   {"/problems/unprocessable-entity", "Unprocessable request"},
+		http.StatusTooManyRequests:       {"/problems/rate-limit-exceeded", "Too many requests"},
+		http.StatusInternalServerError:   {"/problems/internal-error", "Internal server error"},
+	}
+
+	overlappingSubscription = kind{"/problems/overlapping-subscription", "Subscriptions overlap"}
+)
+
+// New builds the problem of the given status
+func New(ctx context.Context, status int, detail string) api.Problem {
+	k, ok := kinds[status]
+	if !ok {
+		// RFC 7807 leaves problems without further semantics to the status code
+		k = kind{typeURI: "about:blank", title: http.StatusText(status)}
+	}
+	return build(ctx, k, status, detail)
+}
+
+// FromError builds the problem of err. Domain errors keep their message and field errors,
+// other errors are reported as internal without their details.
+func FromError(ctx context.Context, err error) api.Problem {
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
+		return New(ctx, http.StatusInternalServerError, domain.ErrInternal.Error())
+	}
+
+	// Overlaps are the one conflict clients resolve differently, so they have a kind of their own
+	if domain.IsOverlapError(err) {
+		return build(ctx, overlappingSubscription, http.StatusConflict, domainErr.Message)
+	}
+
+	problem := New(ctx, domainErr.Code, domainErr.Message)
+	problem.Errors = FieldErrors(domain.FieldErrors(err))
+	return problem
+}
+
+// FieldErrors converts domain field errors to their API representation
+func FieldErrors(fieldErrs []domain.FieldError) []api.FieldError {
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+
+	result := make([]api.FieldError, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		result[i] = api.FieldError{Field: fieldErr.Field, Reason: fieldErr.Reason}
+	}
+	return result
+}
+
+// Write writes p as the response to r
+func Write(w http.ResponseWriter, r *http.Request, p api.Problem) {
+	if !p.Instance.Set {
+		p.Instance = api.NewOptString(r.URL.Path)
+	}
+
+	body, err := p.MarshalJSON()
+	if err != nil {
+		logger.Error().Err(err).Str("request_id", p.RequestID.Or("unknown")).Msg("Failed to encode problem response")
+		w.WriteHeader(int(p.Status))
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(int(p.Status))
+
+	if _, err = w.Write(body); err != nil {
+		logger.Error().Err(err).Str("request_id", p.RequestID.Or("unknown")).Msg("Failed to write problem response")
+	}
+}
+
+func build(ctx context.Context, k kind, status int, detail string) api.Problem {
+	problem := api.Problem{
+		Type:   k.typeURI,
+		Title:  k.title,
+		Status: int32(status),
+		Detail: api.NewOptString(detail),
+	}
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok && requestID != "" {
+		problem.RequestID = api.NewOptString(requestID)
+	}
+	return problem
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+)
+
+const defaultRateLimitMaxClients = 10000
+
+// RateLimitPolicy defines the token bucket applied to matching requests.
+// Method and PathPrefix are ignored for the default policy, an empty Method matches any method.
+type RateLimitPolicy struct {
+	Method     string
+	PathPrefix string
+	Rate       float64 // Requests per second
+	Burst      int
+}
+
+// RateLimitConfig configures per-client rate limiting
+type RateLimitConfig struct {
+	// IP is applied per IP address before authentication, it also limits requests with invalid credentials
+	IP      RateLimitPolicy
+	Default RateLimitPolicy
+	// Routes are matched in order, the first matching policy wins
+	Routes []RateLimitPolicy
+	// MaxClients bounds the number of tracked limiters, the least recently used are evicted
+	MaxClients int
+}
+
+func (p *RateLimitPolicy) matches(r *http.Request) bool {
+	if p.Method != "" && !strings.EqualFold(p.Method, r.Method) {
+		return false
+	}
+	return strings.HasPrefix(r.URL.Path, p.PathPrefix)
+}
+
+// policyFor returns the policy for the request and its index (-1 for the default policy)
+func (c *RateLimitConfig) policyFor(r *http.Request) (*RateLimitPolicy, int) {
+	for i := range c.Routes {
+		if c.Routes[i].matches(r) {
+			return &c.Routes[i], i
+		}
+	}
+	return &c.Default, -1
+}
+
+// limiterStore keeps one limiter per client and policy with LRU eviction
+type limiterStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+type limiterEntry struct {
+	key     string
+	limiter *rate.Limiter
+}
+
+func newLimiterStore(capacity int) *limiterStore {
+	if capacity <= 0 {
+		capacity = defaultRateLimitMaxClients
+	}
+
+	return &limiterStore{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (s *limiterStore) get(key string, policy *RateLimitPolicy) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		s.order.MoveToFront(elem)
+		return elem.Value.(*limiterEntry).limiter
+	}
+
+	if s.order.Len() >= s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*limiterEntry).key)
+	}
+
+	entry := &limiterEntry{key: key, limiter: rate.NewLimiter(rate.Limit(policy.Rate), policy.Burst)}
+	s.items[key] = s.order.PushFront(entry)
+
+	return entry.limiter
+}
+
+// RateLimiter applies a single policy per client key outside of the HTTP middleware, e.g. to gRPC calls
+type RateLimiter struct {
+	policy RateLimitPolicy
+	store  *limiterStore
+}
+
+// NewRateLimiter creates a limiter tracking at most maxClients clients, a non-positive rate disables it
+func NewRateLimiter(policy RateLimitPolicy, maxClients int) *RateLimiter {
+	return &RateLimiter{policy: policy, store: newLimiterStore(maxClients)}
+}
+
+// Allow takes a token for the client, when the bucket is empty it returns false
+// and the whole seconds until the next token is available
+func (l *RateLimiter) Allow(client string) (bool, int) {
+	if l.policy.Rate <= 0 {
+		return true, 0
+	}
+
+	now := time.Now()
+	limiter := l.store.get(client, &l.policy)
+	if limiter.AllowN(now, 1) {
+		return true, 0
+	}
+	return false, secondsUntilTokens(limiter.TokensAt(now), 1, l.policy.Rate)
+}
+
+// ipRateLimitMiddleware limits requests per IP address, it runs before AuthMiddleware
+// so that anonymous requests and attempts with invalid credentials are throttled too
+func ipRateLimitMiddleware(cfg RateLimitConfig) func(http.Handler) http.Handler {
+	store := newLimiterStore(cfg.MaxClients)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if cfg.IP.Rate <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			client := "ip:" + clientIP(r)
+			if allowRequest(w, r, store.get(client, &cfg.IP), &cfg.IP, client) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// rateLimitMiddleware limits requests per client, it must run after AuthMiddleware
+// so that authenticated clients are keyed by their principal rather than by IP
+func rateLimitMiddleware(cfg RateLimitConfig) func(http.Handler) http.Handler {
+	store := newLimiterStore(cfg.MaxClients)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			policy, index := cfg.policyFor(r)
+			if policy.Rate <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			client := rateLimitClientKey(r)
+			if allowRequest(w, r, store.get(strconv.Itoa(index)+"|"+client, policy), policy, client) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// allowRequest takes a token from the limiter and writes the rate limit headers,
+// it responds with 429 and returns false when the bucket is empty
+func allowRequest(w http.ResponseWriter, r *http.Request, limiter *rate.Limiter, policy *RateLimitPolicy, client string) bool {
+	now := time.Now()
+	allowed := limiter.AllowN(now, 1)
+	setRateLimitHeaders(w, policy, limiter.TokensAt(now))
+
+	if allowed {
+		return true
+	}
+
+	retryAfter := secondsUntilTokens(limiter.TokensAt(now), 1, policy.Rate)
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+
+	logger.Warn().
+		Str("client", client).
+		Str("ip", r.RemoteAddr).
+		Str("path", r.URL.Path).
+		Str("request_id", getRequestID(r)).
+		Msg("Rate limit exceeded")
+
+	writeProblem(w, r, http.StatusTooManyRequests,
+		fmt.Sprintf("rate limit exceeded, retry in %d seconds", retryAfter))
+	return false
+}
+
+// rateLimitClientKey identifies the caller by principal (user or API key) or by IP address
+func rateLimitClientKey(r *http.Request) string {
+	if principal, ok := ports.PrincipalFromContext(r.Context()); ok {
+		return "principal:" + principal.TenantID.String() + ":" + principal.Subject
+	}
+	return "ip:" + clientIP(r)
+}
+
+// clientIP returns the IP address of the connection the request came from
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// setRateLimitHeaders writes the RateLimit-* headers of the IETF rate limit fields draft
+func setRateLimitHeaders(w http.ResponseWriter, policy *RateLimitPolicy, tokens float64) {
+	remaining := int(math.Max(0, math.Floor(tokens)))
+	window := int(math.Ceil(float64(policy.Burst) / policy.Rate))
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(policy.Burst))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(secondsUntilTokens(tokens, float64(policy.Burst), policy.Rate)))
+	w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Burst, window))
+}
+
+// secondsUntilTokens returns the whole seconds needed for the bucket to refill up to want tokens
+func secondsUntilTokens(tokens, want, ratePerSecond float64) int {
+	if tokens >= want {
+		return 0
+	}
+	return int(math.Ceil((want - tokens) / ratePerSecond))
+}
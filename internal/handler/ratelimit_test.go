@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"subscription/internal/auth"
+)
+
+func TestIPRateLimitThrottlesFailedAuthentication(t *testing.T) {
+	validator, err := auth.NewValidator(auth.Config{HS256Secret: "test-secret"})
+	if err != nil {
+		t.Fatalf("creating validator: %v", err)
+	}
+
+	cfg := RateLimitConfig{
+		IP:      RateLimitPolicy{Rate: 0.001, Burst: 3},
+		Default: RateLimitPolicy{Rate: 0.001, Burst: 3},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request with invalid credentials reached the handler")
+	})
+	chain := ipRateLimitMiddleware(cfg)(AuthMiddleware(auth.NewAuthenticator(validator, nil))(rateLimitMiddleware(cfg)(adminMiddleware(next))))
+
+	tests := []struct {
+		name   string
+		path   string
+		ip     string
+		status int
+	}{
+		{"first attempt", "/subscriptions", "192.0.2.1:1000", http.StatusUnauthorized},
+		{"second attempt", "/subscriptions", "192.0.2.1:1001", http.StatusUnauthorized},
+		{"third attempt", "/subscriptions", "192.0.2.1:1002", http.StatusUnauthorized},
+		{"burst exhausted", "/subscriptions", "192.0.2.1:1003", http.StatusTooManyRequests},
+		{"still exhausted", "/subscriptions", "192.0.2.1:1004", http.StatusTooManyRequests},
+		{"other address", "/subscriptions", "198.51.100.7:1000", http.StatusUnauthorized},
+		{"admin first attempt", "/admin/api-keys", "198.51.100.7:1001", http.StatusUnauthorized},
+		{"admin second attempt", "/admin/db-stats", "198.51.100.7:1002", http.StatusUnauthorized},
+		{"admin burst exhausted", "/admin/api-keys", "198.51.100.7:1003", http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			r.RemoteAddr = tt.ip
+			r.Header.Set("Authorization", "Bearer invalid-token")
+			w := httptest.NewRecorder()
+
+			chain.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			if tt.status == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+				t.Error("Retry-After header is missing")
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	api "subscription/internal/api/generated"
+)
+
+// Mount serves a path outside of the OpenAPI spec through the same middleware chain as the ogen server
+type Mount struct {
+	// Path is matched exactly
+	Path string
+	// Name labels the metrics and spans of the mount like an ogen operation name
+	Name    string
+	Handler http.Handler
+}
+
+// router dispatches requests to the mounts and everything else to the ogen server
+type router struct {
+	server *api.Server
+	mounts map[string]Mount
+}
+
+func newRouter(server *api.Server, mounts []Mount) *router {
+	byPath := make(map[string]Mount, len(mounts))
+	for _, mount := range mounts {
+		byPath[mount.Path] = mount
+	}
+	return &router{server: server, mounts: byPath}
+}
+
+// findRoute returns the operation name and path pattern serving the request
+func (rt *router) findRoute(r *http.Request) (name, pattern string, ok bool) {
+	if mount, ok := rt.mounts[r.URL.Path]; ok {
+		return mount.Name, mount.Path, true
+	}
+
+	route, ok := rt.server.FindPath(r.Method, r.URL)
+	if !ok {
+		return "", "", false
+	}
+	return route.Name(), route.PathPattern(), true
+}
+
+func (rt *router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if mount, ok := rt.mounts[r.URL.Path]; ok {
+		mount.Handler.ServeHTTP(w, r)
+		return
+	}
+	rt.server.ServeHTTP(w, r)
+}
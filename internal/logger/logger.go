@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"runtime"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -192,6 +194,21 @@ func WithRequestID(requestID string) *zerolog.Logger {
 	return WithField("request_id", requestID)
 }
 
+// WithRequestContext creates a logger with request ID and, when ctx carries a valid span,
+// the OpenTelemetry trace and span IDs
+func WithRequestContext(ctx context.Context, requestID string) *zerolog.Logger {
+	logContext := Get().With().Str("request_id", requestID)
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		logContext = logContext.
+			Str("trace_id", spanContext.TraceID().String()).
+			Str("span_id", spanContext.SpanID().String())
+	}
+
+	logger := logContext.Logger()
+	return &logger
+}
+
 // WithUserID creates a logger with user ID
 func WithUserID(userID string) *zerolog.Logger {
 	return WithField("user_id", userID)
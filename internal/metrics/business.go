@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"subscription/core/ports"
+	"subscription/internal/logger"
+)
+
+// RunSubscriptionStats periodically refreshes the business gauges until ctx is cancelled
+func RunSubscriptionStats(ctx context.Context, repo ports.SubscriptionRepository, interval time.Duration) {
+	// Gauges aggregate all tenants
+	ctx = ports.WithoutTenantScope(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		refreshSubscriptionStats(ctx, repo)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshSubscriptionStats(ctx context.Context, repo ports.SubscriptionRepository) {
+	now := time.Now()
+
+	stats, err := repo.GetActiveStats(ctx, int(now.Month()), now.Year())
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to refresh subscription metrics")
+		return
+	}
+
+	SetSubscriptionStats(stats.ActiveCount, stats.TrialCount, stats.MonthlySpend)
+}
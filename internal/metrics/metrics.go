@@ -0,0 +1,125 @@
+// Package metrics exposes application metrics in the Prometheus format.
+package metrics
+
+import (
+	"database/sql"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"subscription/core/domain"
+)
+
+const namespace = "subscription"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by ogen operation, method and status code.",
+	}, []string{"operation", "method", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by ogen operation and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "SQL query latency by statement type and result.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"statement", "result"})
+
+	activeSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_subscriptions",
+		Help:      "Number of subscriptions active in the current month across all tenants, paused ones are not active.",
+	})
+
+	trialSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "trial_subscriptions",
+		Help:      "Number of active subscriptions in their free trial in the current month across all tenants.",
+	})
+
+	monthlySpend = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monthly_spend",
+		Help:      "Sum of prices in major units including tax of subscriptions charged in the current month across all tenants by currency, trials and pauses are free.",
+	}, []string{"currency"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		dbQueryDuration,
+		activeSubscriptions,
+		trialSubscriptions,
+		monthlySpend,
+	)
+}
+
+// Handler serves the registered metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// RegisterDBStats exposes connection pool statistics of the database
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	return registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// ObserveHTTPRequest records a handled HTTP request
+func ObserveHTTPRequest(operation, method string, status int, duration time.Duration) {
+	httpRequests.WithLabelValues(operation, method, strconv.Itoa(status)).Inc()
+	httpDuration.WithLabelValues(operation, method).Observe(duration.Seconds())
+}
+
+// ObserveDBQuery records an executed SQL statement
+func ObserveDBQuery(sql string, failed bool, duration time.Duration) {
+	result := "ok"
+	if failed {
+		result = "error"
+	}
+	dbQueryDuration.WithLabelValues(statementType(sql), result).Observe(duration.Seconds())
+}
+
+// SetSubscriptionStats updates the business gauges, currencies without spend are dropped
+func SetSubscriptionStats(active, trial int64, spend []domain.Money) {
+	activeSubscriptions.Set(float64(active))
+	trialSubscriptions.Set(float64(trial))
+
+	monthlySpend.Reset()
+	for _, amount := range spend {
+		monthlySpend.WithLabelValues(string(amount.Currency)).Set(float64(amount.Amount) / math.Pow10(amount.Currency.Digits()))
+	}
+}
+
+// statementType reduces SQL to a bounded label value
+func statementType(sql string) string {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+
+	switch keyword = strings.ToLower(keyword); keyword {
+	case "select", "insert", "update", "delete":
+		return keyword
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+	"subscription/internal/repository/postgres/model"
+)
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) ports.APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Create stores a new API key
+func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	dbKey := APIKeyToDBModel(key)
+
+	result := r.db.WithContext(ctx).Create(dbKey)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("api_key_id", key.ID.String()).Msg("Failed to create API key")
+		return domain.ErrInternal
+	}
+	key.TenantID = dbKey.TenantID
+
+	log.Info().Str("api_key_id", key.ID.String()).Msg("API key created successfully")
+	return nil
+}
+
+// GetByID returns API key by ID
+func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	return r.getOne(ctx, "id = ?", id)
+}
+
+// GetByPrefix returns API key by its public prefix.
+// Prefixes are unique across tenants and the tenant is not known before authentication.
+func (r *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	return r.getOne(ports.WithoutTenantScope(ctx), "prefix = ?", prefix)
+}
+
+func (r *APIKeyRepository) getOne(ctx context.Context, query string, arg interface{}) (*domain.APIKey, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	var dbKey model.APIKey
+	result := r.db.WithContext(ctx).Where(query, arg).First(&dbKey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+
+		log.Error().Err(result.Error).Msg("Failed to get API key")
+		return nil, domain.ErrInternal
+	}
+
+	return APIKeyToDomain(&dbKey), nil
+}
+
+// List returns all API keys
+func (r *APIKeyRepository) List(ctx context.Context) ([]*domain.APIKey, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	var dbKeys []model.APIKey
+	result := r.db.WithContext(ctx).Order("created_at DESC").Find(&dbKeys)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("Failed to list API keys")
+		return nil, domain.ErrInternal
+	}
+
+	keys := make([]*domain.APIKey, len(dbKeys))
+	for i := range dbKeys {
+		keys[i] = APIKeyToDomain(&dbKeys[i])
+	}
+
+	return keys, nil
+}
+
+// Update saves the mutable fields of an API key
+func (r *APIKeyRepository) Update(ctx context.Context, key *domain.APIKey) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	dbKey := APIKeyToDBModel(key)
+
+	result := r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ?", key.ID).
+		Updates(map[string]interface{}{
+			"name":       dbKey.Name,
+			"scopes":     dbKey.Scopes,
+			"expires_at": dbKey.ExpiresAt,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("api_key_id", key.ID.String()).Msg("Failed to update API key")
+		return domain.ErrInternal
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	log.Info().Str("api_key_id", key.ID.String()).Msg("API key updated successfully")
+	return nil
+}
+
+// Revoke marks API key as revoked
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	result := r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("api_key_id", id.String()).Msg("Failed to revoke API key")
+		return domain.ErrInternal
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	log.Info().Str("api_key_id", id.String()).Msg("API key revoked successfully")
+	return nil
+}
+
+// TouchLastUsed records when API key was last used, it runs before the tenant is known
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	result := r.db.WithContext(ports.WithoutTenantScope(ctx)).Model(&model.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt)
+	if result.Error != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(result.Error).
+			Str("api_key_id", id.String()).
+			Msg("Failed to update API key last used time")
+		return domain.ErrInternal
+	}
+
+	return nil
+}
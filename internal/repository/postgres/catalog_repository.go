@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+	"subscription/internal/repository/postgres/model"
+)
+
+type CatalogRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) ports.CatalogRepository {
+	return &CatalogRepository{db: db}
+}
+
+// Create stores a new catalog entry
+func (r *CatalogRepository) Create(ctx context.Context, service *domain.Service) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	dbService := ServiceToDBModel(service)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkServiceKeys(ctx, tx, dbService); err != nil {
+			return err
+		}
+		return tx.Create(dbService).Error
+	})
+	if errors.Is(err, domain.ErrServiceNameTaken) {
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("service_id", service.ID.String()).Msg("Failed to create service")
+		return domain.ErrInternal
+	}
+	service.TenantID = dbService.TenantID
+
+	log.Info().Str("service_id", service.ID.String()).Msg("Service created successfully")
+	return nil
+}
+
+// GetByID returns catalog entry by ID
+func (r *CatalogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Service, error) {
+	return r.getOne(ctx, "id = ?", id)
+}
+
+// FindByName returns the catalog entry with a matching name or alias
+func (r *CatalogRepository) FindByName(ctx context.Context, name string) (*domain.Service, error) {
+	return r.getOne(ctx, "keys @> ?::text[]", model.StringArray{domain.NormalizeServiceName(name)})
+}
+
+func (r *CatalogRepository) getOne(ctx context.Context, query string, arg interface{}) (*domain.Service, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	var dbService model.Service
+	result := r.db.WithContext(ctx).Where(query, arg).First(&dbService)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrServiceNotFound
+		}
+
+		log.Error().Err(result.Error).Msg("Failed to get service")
+		return nil, domain.ErrInternal
+	}
+
+	return ServiceToDomain(&dbService), nil
+}
+
+// List returns the catalog entries ordered by name
+func (r *CatalogRepository) List(ctx context.Context, category string) ([]*domain.Service, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	query := r.db.WithContext(ctx).Order("name")
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	var dbServices []model.Service
+	if err := query.Find(&dbServices).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to list services")
+		return nil, domain.ErrInternal
+	}
+
+	services := make([]*domain.Service, len(dbServices))
+	for i := range dbServices {
+		services[i] = ServiceToDomain(&dbServices[i])
+	}
+
+	return services, nil
+}
+
+// Update saves a catalog entry. Subscriptions referencing it take over a changed name
+// in the same transaction and are recorded as updated.
+func (r *CatalogRepository) Update(ctx context.Context, service *domain.Service) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	dbService := ServiceToDBModel(service)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkServiceKeys(ctx, tx, dbService); err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.Service{}).
+			Where("id = ?", service.ID).
+			Updates(map[string]interface{}{
+				"name":             dbService.Name,
+				"category":         dbService.Category,
+				"default_price":    dbService.DefaultPrice,
+				"default_currency": dbService.DefaultCurrency,
+				"billing_cycle":    dbService.BillingCycle,
+				"aliases":          dbService.Aliases,
+				"keys":             dbService.Keys,
+				"updated_at":       time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrServiceNotFound
+		}
+
+		return renameSubscriptions(ctx, tx, dbService)
+	})
+	if errors.Is(err, domain.ErrServiceNotFound) || errors.Is(err, domain.ErrServiceNameTaken) {
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("service_id", service.ID.String()).Msg("Failed to update service")
+		return domain.ErrInternal
+	}
+
+	log.Info().Str("service_id", service.ID.String()).Msg("Service updated successfully")
+	return nil
+}
+
+// Delete removes a catalog entry that no subscription references
+func (r *CatalogRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var referenced int64
+		if err := tx.Model(&model.Subscription{}).Where("service_id = ?", id).Count(&referenced).Error; err != nil {
+			return err
+		}
+		if referenced > 0 {
+			return domain.ErrServiceInUse
+		}
+
+		result := tx.Where("id = ?", id).Delete(&model.Service{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrServiceNotFound
+		}
+		return nil
+	})
+	if errors.Is(err, domain.ErrServiceNotFound) || errors.Is(err, domain.ErrServiceInUse) {
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("service_id", id.String()).Msg("Failed to delete service")
+		return domain.ErrInternal
+	}
+
+	log.Info().Str("service_id", id.String()).Msg("Service deleted successfully")
+	return nil
+}
+
+// checkServiceKeys fails with ErrServiceNameTaken when another catalog entry shares a name or alias.
+// The catalog lock of the tenant is held until commit, so concurrent changes are checked one after another.
+func checkServiceKeys(ctx context.Context, tx *gorm.DB, dbService *model.Service) error {
+	tenantID, _ := ports.TenantFromContext(ctx)
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "services:"+tenantID.String()).Error; err != nil {
+		return err
+	}
+
+	var taken int64
+	err := tx.Model(&model.Service{}).
+		Where("id <> ? AND keys && ?::text[]", dbService.ID, dbService.Keys).
+		Count(&taken).Error
+	if err != nil {
+		return err
+	}
+	if taken > 0 {
+		return domain.ErrServiceNameTaken
+	}
+	return nil
+}
+
+// renameSubscriptions gives the subscriptions referencing the catalog entry its current name
+func renameSubscriptions(ctx context.Context, tx *gorm.DB, dbService *model.Service) error {
+	var dbSubs []model.Subscription
+	err := tx.Where("service_id = ? AND service_name <> ?", dbService.ID, dbService.Name).Find(&dbSubs).Error
+	if err != nil {
+		return err
+	}
+
+	for i := range dbSubs {
+		dbSub := &dbSubs[i]
+		dbSub.ServiceName = dbService.Name
+		dbSub.UpdatedAt = time.Now()
+
+		err := tx.Model(dbSub).Updates(map[string]interface{}{
+			"service_name": dbSub.ServiceName,
+			"updated_at":   dbSub.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err = recordEvent(ctx, tx, domain.SubscriptionUpdated, dbSub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
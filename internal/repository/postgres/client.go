@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	appLogger "subscription/internal/logger" // Алиас для вашего логгера
@@ -12,6 +13,7 @@ import (
 // Client wraps the GORM DB instance with connection management.
 type Client struct {
 	*gorm.DB
+	legacyTenant uuid.UUID
 }
 
 // Params holds settings for the database connection.
@@ -22,6 +24,16 @@ type Params struct {
 	Password string
 	Name     string
 	SSLMode  string
+
+	// Connection pool settings
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	// LegacyTenant receives the rows created before tenancy was introduced, they stay with
+	// the nil tenant that no principal can act in when it is not set
+	LegacyTenant uuid.UUID
 }
 
 // NewClient creates a new database connection with zerolog integration.
@@ -71,20 +83,28 @@ func NewClient(p Params) (*Client, error) {
 		return nil, fmt.Errorf("failed to connect to database after %d attempts: %w", maxRetries, err)
 	}
 
+	if err = registerTenantScope(db); err != nil {
+		return nil, fmt.Errorf("registering tenant scope: %w", err)
+	}
+
+	if err = registerTracing(db); err != nil {
+		return nil, fmt.Errorf("registering tracing: %w", err)
+	}
+
 	// Configure connection pool
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("getting underlying sql.DB: %w", err)
 	}
 
-	sqlDB.SetMaxOpenConns(25)
-	sqlDB.SetMaxIdleConns(5)
-	sqlDB.SetConnMaxLifetime(30 * time.Minute)
-	sqlDB.SetConnMaxIdleTime(10 * time.Minute)
+	sqlDB.SetMaxOpenConns(p.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(p.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(p.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(p.ConnMaxIdleTime)
 
 	appLogger.Info().Msg("Database connection established successfully")
 
-	return &Client{DB: db}, nil
+	return &Client{DB: db, legacyTenant: p.LegacyTenant}, nil
 }
 
 // HealthCheck проверяет соединение с БД.
@@ -114,10 +134,48 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// legacyIndexes are indexes replaced by newer definitions and dropped before migration.
+var legacyIndexes = []string{
+	"idx_user_service_unique",        // replaced by idx_tenant_user_service_unique
+	"idx_tenant_user_service_unique", // replaced by the overlap check, a user may subscribe to a service again
+}
+
+// minorUnitConversions convert amounts stored in whole units before prices had a currency to minor units,
+// shares and discount values are only amounts for fixed splits and discounts.
+var minorUnitConversions = []struct{ table, sql string }{
+	{"subscriptions", "ALTER TABLE subscriptions ALTER COLUMN price TYPE bigint USING price * 100"},
+	{"subscription_events", "ALTER TABLE subscription_events ALTER COLUMN price TYPE bigint USING price * 100"},
+	{"services", "ALTER TABLE services ALTER COLUMN default_price TYPE bigint USING default_price * 100"},
+	{"subscription_members", "ALTER TABLE subscription_members ALTER COLUMN share TYPE bigint, ALTER COLUMN amount TYPE bigint USING amount * 100"},
+	{"subscription_members", "UPDATE subscription_members m SET share = m.share * 100 FROM subscriptions s WHERE s.id = m.subscription_id AND s.split = 'fixed'"},
+	{"subscription_discounts", "ALTER TABLE subscription_discounts ALTER COLUMN value TYPE bigint"},
+	{"subscription_discounts", "UPDATE subscription_discounts SET value = value * 100 WHERE kind = 'fixed'"},
+}
+
+// tenancyTables were created before tenancy was introduced, their rows get the nil tenant
+var tenancyTables = []string{"subscriptions", "api_keys", "idempotency_keys"}
+
 // Migrate runs schema migrations for the given model.
 func (c *Client) Migrate(models ...interface{}) error {
 	appLogger.Info().Msg("Starting database migration")
 
+	for _, index := range legacyIndexes {
+		if err := c.DB.Exec("DROP INDEX IF EXISTS " + index).Error; err != nil {
+			appLogger.Error().Err(err).Str("index", index).Msg("Failed to drop legacy index")
+			return fmt.Errorf("dropping legacy index %s: %w", index, err)
+		}
+	}
+
+	if err := c.addTenancy(); err != nil {
+		appLogger.Error().Err(err).Msg("Failed to add tenant columns")
+		return fmt.Errorf("adding tenant columns: %w", err)
+	}
+
+	if err := c.convertToMinorUnits(); err != nil {
+		appLogger.Error().Err(err).Msg("Failed to convert amounts to minor units")
+		return fmt.Errorf("converting amounts to minor units: %w", err)
+	}
+
 	if err := c.DB.AutoMigrate(models...); err != nil {
 		appLogger.Error().
 			Err(err).
@@ -125,10 +183,123 @@ func (c *Client) Migrate(models ...interface{}) error {
 		return fmt.Errorf("schema migration: %w", err)
 	}
 
+	if err := c.assignLegacyTenant(models...); err != nil {
+		appLogger.Error().Err(err).Msg("Failed to assign rows without tenant to the legacy tenant")
+		return fmt.Errorf("assigning legacy tenant: %w", err)
+	}
+
 	appLogger.Info().Msg("Schema migration completed successfully")
 	return nil
 }
 
+// addTenancy adds the tenant_id column to tables created before tenancy, backfilled with the nil tenant,
+// and makes the tenant part of the idempotency key primary key. AutoMigrate changes neither an
+// existing primary key nor adds a not null column without default to a table with rows.
+func (c *Client) addTenancy() error {
+	return c.DB.Transaction(func(tx *gorm.DB) error {
+		migrator := tx.Migrator()
+		for _, table := range tenancyTables {
+			if !migrator.HasTable(table) || migrator.HasColumn(table, "tenant_id") {
+				continue
+			}
+			sql := fmt.Sprintf("ALTER TABLE %s ADD COLUMN tenant_id uuid NOT NULL DEFAULT '%s'", table, uuid.Nil)
+			if err := tx.Exec(sql).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("ALTER TABLE " + table + " ALTER COLUMN tenant_id DROP DEFAULT").Error; err != nil {
+				return err
+			}
+		}
+
+		if !migrator.HasTable("idempotency_keys") {
+			return nil
+		}
+		var primaryKey struct {
+			Name      string
+			HasTenant bool
+		}
+		err := tx.Raw(`SELECT c.conname AS name, bool_or(a.attname = 'tenant_id') AS has_tenant
+			FROM pg_constraint c
+			JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+			WHERE c.conrelid = 'idempotency_keys'::regclass AND c.contype = 'p'
+			GROUP BY c.conname`).Scan(&primaryKey).Error
+		if err != nil || primaryKey.HasTenant {
+			return err
+		}
+		if primaryKey.Name != "" {
+			if err = tx.Exec(`ALTER TABLE idempotency_keys DROP CONSTRAINT "` + primaryKey.Name + `"`).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Exec("ALTER TABLE idempotency_keys ADD PRIMARY KEY (tenant_id, key, route)").Error
+	})
+}
+
+// assignLegacyTenant moves the rows of the nil tenant in tenant-scoped tables to the legacy tenant.
+// Without a legacy tenant it only warns about them, they can't be reached through the API.
+func (c *Client) assignLegacyTenant(models ...interface{}) error {
+	return c.DB.Transaction(func(tx *gorm.DB) error {
+		for _, m := range models {
+			stmt := &gorm.Statement{DB: tx}
+			if err := stmt.Parse(m); err != nil {
+				return err
+			}
+			if stmt.Schema.LookUpField(tenantField) == nil {
+				continue
+			}
+
+			table := stmt.Schema.Table
+			if c.legacyTenant == uuid.Nil {
+				var count int64
+				if err := tx.Table(table).Where("tenant_id = ?", uuid.Nil).Count(&count).Error; err != nil {
+					return err
+				}
+				if count > 0 {
+					appLogger.Warn().
+						Str("table", table).
+						Int64("rows", count).
+						Msg("Rows without tenant are unreachable, set DB_LEGACY_TENANT to assign them to a tenant")
+				}
+				continue
+			}
+
+			result := tx.Exec("UPDATE "+table+" SET tenant_id = ? WHERE tenant_id = ?", c.legacyTenant, uuid.Nil)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				appLogger.Info().
+					Str("table", table).
+					Int64("rows", result.RowsAffected).
+					Str("tenant", c.legacyTenant.String()).
+					Msg("Assigned rows without tenant to the legacy tenant")
+			}
+		}
+		return nil
+	})
+}
+
+// convertToMinorUnits runs the minor unit conversions once, on databases with subscriptions without currency
+func (c *Client) convertToMinorUnits() error {
+	return c.DB.Transaction(func(tx *gorm.DB) error {
+		migrator := tx.Migrator()
+		if !migrator.HasTable("subscriptions") || migrator.HasColumn("subscriptions", "currency") {
+			return nil
+		}
+
+		for _, conversion := range minorUnitConversions {
+			if !migrator.HasTable(conversion.table) {
+				continue
+			}
+			if err := tx.Exec(conversion.sql).Error; err != nil {
+				return err
+			}
+		}
+		// Marks the conversion as done, AutoMigrate finds the column in place
+		return tx.Exec("ALTER TABLE subscriptions ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'RUB'").Error
+	})
+}
+
 // WithTx executes a function within a transaction.
 func (c *Client) WithTx(fn func(tx *gorm.DB) error) error {
 	return c.DB.Transaction(fn)
@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"github.com/google/uuid"
+
 	"subscription/core/domain"
 	"subscription/internal/repository/postgres/model"
 )
@@ -12,13 +14,24 @@ func ToDBModel(domainSub *domain.Subscription) (*model.Subscription, error) {
 		return nil, err
 	}
 
+	taxInclusive := domainSub.Tax.Inclusive
 	dbSub := &model.Subscription{
-		ID:          domainSub.ID,
-		ServiceName: domainSub.ServiceName,
-		Price:       domainSub.Price,
-		UserID:      domainSub.UserID,
-		StartMonth:  startMonth,
-		StartYear:   startYear,
+		ID:                 domainSub.ID,
+		ServiceName:        domainSub.ServiceName,
+		Price:              domainSub.Price.Amount,
+		Currency:           string(domainSub.Price.Currency),
+		TaxRate:            domainSub.Tax.Rate,
+		TaxInclusive:       &taxInclusive,
+		UserID:             domainSub.UserID,
+		StartMonth:         startMonth,
+		StartYear:          startYear,
+		ServiceID:          domainSub.ServiceID,
+		Category:           domainSub.Category,
+		Tags:               model.StringArray(domainSub.Tags),
+		CancelledAt:        domainSub.CancelledAt,
+		CancellationReason: domainSub.CancellationReason,
+		Split:              string(domainSub.Split),
+		Members:            MembersToDBModel(domainSub.ID, domainSub.Members),
 	}
 
 	if domainSub.EndDate != nil {
@@ -30,6 +43,35 @@ func ToDBModel(domainSub *domain.Subscription) (*model.Subscription, error) {
 		dbSub.EndYear = &endYear
 	}
 
+	if domainSub.TrialEndDate != nil {
+		trialEndMonth, trialEndYear, err := parseMMYYYY(*domainSub.TrialEndDate)
+		if err != nil {
+			return nil, err
+		}
+		dbSub.TrialEndMonth = &trialEndMonth
+		dbSub.TrialEndYear = &trialEndYear
+	}
+
+	for _, pause := range domainSub.Pauses {
+		dbPause := model.SubscriptionPause{SubscriptionID: domainSub.ID}
+		if dbPause.StartMonth, dbPause.StartYear, err = parseMMYYYY(pause.StartDate); err != nil {
+			return nil, err
+		}
+		if pause.EndDate != nil {
+			endMonth, endYear, err := parseMMYYYY(*pause.EndDate)
+			if err != nil {
+				return nil, err
+			}
+			dbPause.EndMonth = &endMonth
+			dbPause.EndYear = &endYear
+		}
+		dbSub.Pauses = append(dbSub.Pauses, dbPause)
+	}
+
+	if dbSub.Discounts, err = DiscountsToDBModel(domainSub.ID, domainSub.Discounts); err != nil {
+		return nil, err
+	}
+
 	return dbSub, nil
 }
 
@@ -43,12 +85,232 @@ func ToDomain(dbSub *model.Subscription) (*domain.Subscription, error) {
 		endDate = &formatted
 	}
 
-	return domain.NewSubscription(
+	var trialEndDate *string
+	if dbSub.TrialEndMonth != nil && dbSub.TrialEndYear != nil {
+		formatted := formatMMYYYY(*dbSub.TrialEndMonth, *dbSub.TrialEndYear)
+		trialEndDate = &formatted
+	}
+
+	domainSub, err := domain.NewSubscription(
 		dbSub.ID,
 		dbSub.ServiceName,
-		dbSub.Price,
+		domain.NewMoney(dbSub.Price, domain.Currency(dbSub.Currency)),
 		dbSub.UserID,
 		startDate,
 		endDate,
+		trialEndDate,
 	)
+	if err != nil {
+		return nil, err
+	}
+	domainSub.ServiceID = dbSub.ServiceID
+	domainSub.Tax.Rate = dbSub.TaxRate
+	if dbSub.TaxInclusive != nil {
+		domainSub.Tax.Inclusive = *dbSub.TaxInclusive
+	}
+	domainSub.Category = dbSub.Category
+	domainSub.Tags = append([]string{}, dbSub.Tags...)
+	domainSub.CancelledAt = dbSub.CancelledAt
+	domainSub.CancellationReason = dbSub.CancellationReason
+
+	for _, dbPause := range dbSub.Pauses {
+		pause := domain.Pause{StartDate: formatMMYYYY(dbPause.StartMonth, dbPause.StartYear)}
+		if dbPause.EndMonth != nil && dbPause.EndYear != nil {
+			formatted := formatMMYYYY(*dbPause.EndMonth, *dbPause.EndYear)
+			pause.EndDate = &formatted
+		}
+		domainSub.Pauses = append(domainSub.Pauses, pause)
+	}
+
+	for _, dbDiscount := range dbSub.Discounts {
+		discount := domain.Discount{
+			Kind:      domain.DiscountKind(dbDiscount.Kind),
+			Value:     dbDiscount.Value,
+			StartDate: formatMMYYYY(dbDiscount.StartMonth, dbDiscount.StartYear),
+		}
+		if dbDiscount.EndMonth != nil && dbDiscount.EndYear != nil {
+			formatted := formatMMYYYY(*dbDiscount.EndMonth, *dbDiscount.EndYear)
+			discount.EndDate = &formatted
+		}
+		domainSub.Discounts = append(domainSub.Discounts, discount)
+	}
+
+	domainSub.Split = domain.SplitRule(dbSub.Split)
+	for _, dbMember := range dbSub.Members {
+		domainSub.Members = append(domainSub.Members, domain.Member{
+			UserID: dbMember.UserID,
+			Share:  dbMember.Share,
+			Amount: dbMember.Amount,
+		})
+	}
+
+	return domainSub, nil
+}
+
+// MembersToDBModel converts the members of a subscription to DB models keeping their order
+func MembersToDBModel(subscriptionID uuid.UUID, members []domain.Member) []model.SubscriptionMember {
+	dbMembers := make([]model.SubscriptionMember, len(members))
+	for i, member := range members {
+		dbMembers[i] = model.SubscriptionMember{
+			SubscriptionID: subscriptionID,
+			UserID:         member.UserID,
+			Position:       i,
+			Share:          member.Share,
+			Amount:         member.Amount,
+		}
+	}
+	return dbMembers
+}
+
+// DiscountsToDBModel converts the discounts of a subscription to DB models
+func DiscountsToDBModel(subscriptionID uuid.UUID, discounts []domain.Discount) ([]model.SubscriptionDiscount, error) {
+	dbDiscounts := make([]model.SubscriptionDiscount, len(discounts))
+	for i, discount := range discounts {
+		dbDiscounts[i] = model.SubscriptionDiscount{
+			SubscriptionID: subscriptionID,
+			Kind:           string(discount.Kind),
+			Value:          discount.Value,
+		}
+
+		var err error
+		if dbDiscounts[i].StartMonth, dbDiscounts[i].StartYear, err = parseMMYYYY(discount.StartDate); err != nil {
+			return nil, err
+		}
+		if discount.EndDate != nil {
+			endMonth, endYear, err := parseMMYYYY(*discount.EndDate)
+			if err != nil {
+				return nil, err
+			}
+			dbDiscounts[i].EndMonth = &endMonth
+			dbDiscounts[i].EndYear = &endYear
+		}
+	}
+	return dbDiscounts, nil
+}
+
+// SubscriptionEventToDBModel records the state of a DB subscription as an event of the given type
+func SubscriptionEventToDBModel(eventType domain.SubscriptionEventType, dbSub *model.Subscription) *model.SubscriptionEvent {
+	return &model.SubscriptionEvent{
+		Type:           string(eventType),
+		SubscriptionID: dbSub.ID,
+		UserID:         dbSub.UserID,
+		ServiceName:    dbSub.ServiceName,
+		Price:          dbSub.Price,
+		Currency:       dbSub.Currency,
+		StartMonth:     dbSub.StartMonth,
+		StartYear:      dbSub.StartYear,
+		EndMonth:       dbSub.EndMonth,
+		EndYear:        dbSub.EndYear,
+		TrialEndMonth:  dbSub.TrialEndMonth,
+		TrialEndYear:   dbSub.TrialEndYear,
+	}
+}
+
+// SubscriptionEventToDomain converts a DB model to domain SubscriptionEvent
+func SubscriptionEventToDomain(dbEvent *model.SubscriptionEvent) (*domain.SubscriptionEvent, error) {
+	subscription, err := ToDomain(&model.Subscription{
+		ID:            dbEvent.SubscriptionID,
+		UserID:        dbEvent.UserID,
+		ServiceName:   dbEvent.ServiceName,
+		Price:         dbEvent.Price,
+		Currency:      dbEvent.Currency,
+		StartMonth:    dbEvent.StartMonth,
+		StartYear:     dbEvent.StartYear,
+		EndMonth:      dbEvent.EndMonth,
+		EndYear:       dbEvent.EndYear,
+		TrialEndMonth: dbEvent.TrialEndMonth,
+		TrialEndYear:  dbEvent.TrialEndYear,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SubscriptionEvent{
+		ID:           dbEvent.ID,
+		Type:         domain.SubscriptionEventType(dbEvent.Type),
+		Subscription: subscription,
+		OccurredAt:   dbEvent.CreatedAt,
+	}, nil
+}
+
+// APIKeyToDBModel converts domain APIKey to DB model
+func APIKeyToDBModel(key *domain.APIKey) *model.APIKey {
+	scopes := make(model.StringArray, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &model.APIKey{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Hash:       key.Hash,
+		Scopes:     scopes,
+		CreatedAt:  key.CreatedAt,
+		UpdatedAt:  key.UpdatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+// APIKeyToDomain converts a DB model to domain APIKey
+func APIKeyToDomain(dbKey *model.APIKey) *domain.APIKey {
+	scopes := make([]domain.APIKeyScope, len(dbKey.Scopes))
+	for i, scope := range dbKey.Scopes {
+		scopes[i] = domain.APIKeyScope(scope)
+	}
+
+	return &domain.APIKey{
+		ID:         dbKey.ID,
+		Name:       dbKey.Name,
+		Prefix:     dbKey.Prefix,
+		Hash:       dbKey.Hash,
+		Scopes:     scopes,
+		CreatedAt:  dbKey.CreatedAt,
+		UpdatedAt:  dbKey.UpdatedAt,
+		ExpiresAt:  dbKey.ExpiresAt,
+		LastUsedAt: dbKey.LastUsedAt,
+		RevokedAt:  dbKey.RevokedAt,
+		TenantID:   dbKey.TenantID,
+	}
+}
+
+// ServiceToDBModel converts domain Service to DB model
+func ServiceToDBModel(service *domain.Service) *model.Service {
+	dbService := &model.Service{
+		ID:              service.ID,
+		Name:            service.Name,
+		Category:        service.Category,
+		DefaultCurrency: string(domain.DefaultCurrency),
+		BillingCycle:    string(service.BillingCycle),
+		Aliases:         model.StringArray(service.Aliases),
+		Keys:            model.StringArray(service.Keys()),
+		CreatedAt:       service.CreatedAt,
+		UpdatedAt:       service.UpdatedAt,
+	}
+	if service.DefaultPrice != nil {
+		dbService.DefaultPrice = &service.DefaultPrice.Amount
+		dbService.DefaultCurrency = string(service.DefaultPrice.Currency)
+	}
+	return dbService
+}
+
+// ServiceToDomain converts a DB model to domain Service
+func ServiceToDomain(dbService *model.Service) *domain.Service {
+	service := &domain.Service{
+		ID:           dbService.ID,
+		Name:         dbService.Name,
+		Category:     dbService.Category,
+		BillingCycle: domain.BillingCycle(dbService.BillingCycle),
+		Aliases:      []string(dbService.Aliases),
+		CreatedAt:    dbService.CreatedAt,
+		UpdatedAt:    dbService.UpdatedAt,
+		TenantID:     dbService.TenantID,
+	}
+	if dbService.DefaultPrice != nil {
+		price := domain.NewMoney(*dbService.DefaultPrice, domain.Currency(dbService.DefaultCurrency))
+		service.DefaultPrice = &price
+	}
+	return service
 }
@@ -7,6 +7,7 @@ import (
 
 	gormLogger "gorm.io/gorm/logger"
 	appLogger "subscription/internal/logger"
+	"subscription/internal/metrics"
 )
 
 // ZerologLogger implements gorm.Logger.Interface for zerolog
@@ -51,15 +52,18 @@ func (l *ZerologLogger) Error(ctx context.Context, msg string, data ...interface
 
 // Trace logs SQL queries, timing, and errors
 func (l *ZerologLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	sql, rows := fc()
+
+	failed := err != nil && !errors.Is(err, gormLogger.ErrRecordNotFound)
+	metrics.ObserveDBQuery(sql, failed, elapsed)
+
 	if l.LogLevel <= gormLogger.Silent {
 		return
 	}
 
-	elapsed := time.Since(begin)
-	sql, rows := fc()
-
 	switch {
-	case err != nil && !errors.Is(err, gormLogger.ErrRecordNotFound):
+	case failed:
 		appLogger.Error().
 			Err(err).
 			Str("sql", sql).
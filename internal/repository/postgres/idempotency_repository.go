@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/logger"
+	"subscription/internal/repository/postgres/model"
+)
+
+type IdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) ports.IdempotencyStore {
+	return &IdempotencyRepository{db: db}
+}
+
+// Reserve claims the idempotency key or returns the already stored record
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key, route, requestHash string, ttl time.Duration) (*ports.IdempotencyRecord, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	now := time.Now()
+	record := model.IdempotencyKey{
+		Key:         key,
+		Route:       route,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	// An expired record for the same key must not block a new reservation
+	result := r.db.WithContext(ctx).
+		Where("key = ? AND route = ? AND expires_at < ?", key, route, now).
+		Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("idempotency_key", key).Msg("Failed to delete expired idempotency key")
+		return nil, domain.ErrInternal
+	}
+
+	result = r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&record)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("idempotency_key", key).Msg("Failed to reserve idempotency key")
+		return nil, domain.ErrInternal
+	}
+
+	if result.RowsAffected == 1 {
+		log.Debug().Str("idempotency_key", key).Str("route", route).Msg("Idempotency key reserved")
+		return nil, nil
+	}
+
+	var existing model.IdempotencyKey
+	result = r.db.WithContext(ctx).
+		Where("key = ? AND route = ?", key, route).
+		First(&existing)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			// The conflicting record was released concurrently, let the caller retry
+			return nil, domain.ErrIdempotencyKeyInProgress
+		}
+		log.Error().Err(result.Error).Str("idempotency_key", key).Msg("Failed to load idempotency key")
+		return nil, domain.ErrInternal
+	}
+
+	return toIdempotencyRecord(&existing), nil
+}
+
+// Complete stores the response for a reserved idempotency key
+func (r *IdempotencyRepository) Complete(ctx context.Context, key, route string, statusCode int, contentType string, body []byte) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	result := r.db.WithContext(ctx).Model(&model.IdempotencyKey{}).
+		Where("key = ? AND route = ?", key, route).
+		Updates(map[string]interface{}{
+			"status_code":   statusCode,
+			"content_type":  contentType,
+			"response_body": body,
+			"completed":     true,
+		})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("idempotency_key", key).Msg("Failed to store idempotent response")
+		return domain.ErrInternal
+	}
+
+	log.Debug().Str("idempotency_key", key).Int("status", statusCode).Msg("Idempotent response stored")
+	return nil
+}
+
+// Release deletes a reserved idempotency key
+func (r *IdempotencyRepository) Release(ctx context.Context, key, route string) error {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	result := r.db.WithContext(ctx).
+		Where("key = ? AND route = ?", key, route).
+		Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("idempotency_key", key).Msg("Failed to release idempotency key")
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// DeleteExpired removes expired idempotency keys of all tenants
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ports.WithoutTenantScope(ctx)).
+		Where("expires_at < ?", time.Now()).
+		Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		logger.Error().Err(result.Error).Msg("Failed to delete expired idempotency keys")
+		return 0, domain.ErrInternal
+	}
+
+	return result.RowsAffected, nil
+}
+
+func toIdempotencyRecord(m *model.IdempotencyKey) *ports.IdempotencyRecord {
+	return &ports.IdempotencyRecord{
+		Key:          m.Key,
+		Route:        m.Route,
+		RequestHash:  m.RequestHash,
+		ContentType:  m.ContentType,
+		ResponseBody: m.ResponseBody,
+		StatusCode:   m.StatusCode,
+		Completed:    m.Completed,
+		ExpiresAt:    m.ExpiresAt,
+	}
+}
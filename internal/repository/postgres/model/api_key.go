@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKey represents the database model for hashed API keys
+type APIKey struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time `gorm:"index"`
+
+	Name   string      `gorm:"type:varchar(255);not null"`
+	Prefix string      `gorm:"type:varchar(32);not null;uniqueIndex"`
+	Hash   string      `gorm:"type:char(64);not null"`
+	Scopes StringArray `gorm:"type:text[];not null"`
+
+	ID uuid.UUID `gorm:"type:uuid;primaryKey"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;index"`
+}
+
+// TableName specifies the table name
+func (*APIKey) TableName() string {
+	return "api_keys"
+}
+
+// BeforeCreate GORM hook
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey represents the database model for stored idempotent responses
+type IdempotencyKey struct {
+	CreatedAt time.Time
+	ExpiresAt time.Time `gorm:"not null;index"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Key         string    `gorm:"type:varchar(255);primaryKey"`
+	Route       string    `gorm:"type:varchar(512);primaryKey"`
+	RequestHash string    `gorm:"type:char(64);not null"`
+
+	ContentType  string `gorm:"type:varchar(255)"`
+	ResponseBody []byte `gorm:"type:bytea"`
+	StatusCode   int    `gorm:"not null;default:0"`
+	Completed    bool   `gorm:"not null;default:false"`
+}
+
+// TableName specifies the table name
+func (*IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
@@ -0,0 +1,15 @@
+package model
+
+// All returns the models managed by schema migrations
+func All() []interface{} {
+	return []interface{}{
+		&Service{},
+		&Subscription{},
+		&SubscriptionPause{},
+		&SubscriptionMember{},
+		&SubscriptionDiscount{},
+		&APIKey{},
+		&IdempotencyKey{},
+		&SubscriptionEvent{},
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Service represents the database model for service catalog entries
+type Service struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DefaultPrice is in minor units of DefaultCurrency
+	DefaultPrice    *int64 `gorm:"type:bigint;check:default_price > 0"`
+	DefaultCurrency string `gorm:"type:varchar(3);not null;default:'RUB'"`
+
+	Name         string      `gorm:"type:varchar(255);not null"`
+	Category     string      `gorm:"type:varchar(100);not null;default:'';index"`
+	BillingCycle string      `gorm:"type:varchar(16);not null;default:'monthly'"`
+	Aliases      StringArray `gorm:"type:text[];not null"`
+	// Keys are the normalized name and aliases, unique within the tenant
+	Keys StringArray `gorm:"type:text[];not null;index:,type:gin"`
+
+	ID uuid.UUID `gorm:"type:uuid;primaryKey"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;index"`
+}
+
+// TableName specifies the table name
+func (*Service) TableName() string {
+	return "services"
+}
+
+// BeforeCreate GORM hook
+func (s *Service) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StringArray maps a Go string slice to a PostgreSQL text[] column
+type StringArray []string
+
+// Value implements driver.Valuer
+func (a StringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+
+	buf, err := pgtype.NewMap().Encode(pgtype.TextArrayOID, pgtype.TextFormatCode, pgtype.FlatArray[string](a), nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+// Scan implements sql.Scanner
+func (a *StringArray) Scan(src interface{}) error {
+	var buf []byte
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		buf = []byte(v)
+	case []byte:
+		buf = v
+	default:
+		return fmt.Errorf("unsupported type %T for StringArray", src)
+	}
+
+	var result pgtype.FlatArray[string]
+	if err := pgtype.NewMap().Scan(pgtype.TextArrayOID, pgtype.TextFormatCode, buf, &result); err != nil {
+		return err
+	}
+	*a = StringArray(result)
+	return nil
+}
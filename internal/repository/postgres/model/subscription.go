@@ -15,14 +15,52 @@ type Subscription struct {
 	EndMonth *int `gorm:"check:end_month >= 1 AND end_month <= 12;index:idx_end_date"`
 	EndYear  *int `gorm:"index:idx_end_date"`
 
-	ServiceName string `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_service_unique;index"`
-	Price       int    `gorm:"not null;check:price > 0"`
+	// Last month of the free trial, trial months are not charged
+	TrialEndMonth *int `gorm:"check:trial_end_month >= 1 AND trial_end_month <= 12;index:idx_trial_end_date"`
+	TrialEndYear  *int `gorm:"index:idx_trial_end_date"`
+
+	ServiceName string `gorm:"type:varchar(255);not null;index:idx_tenant_user_service;index"`
+
+	// Price is the monthly price in minor units of Currency, e.g. kopecks
+	Price    int64  `gorm:"type:bigint;not null;check:price > 0"`
+	Currency string `gorm:"type:varchar(3);not null;default:'RUB';index"`
+
+	// TaxRate is the VAT rate in basis points, TaxInclusive tells if Price includes it.
+	// TaxInclusive is a pointer as GORM would insert the column default instead of false.
+	TaxRate      int   `gorm:"not null;default:0;check:tax_rate >= 0 AND tax_rate <= 10000"`
+	TaxInclusive *bool `gorm:"not null;default:true"`
 
 	// Date fields
 	StartMonth int       `gorm:"not null;check:start_month >= 1 AND start_month <= 12;index:idx_start_date"`
 	StartYear  int       `gorm:"not null;index:idx_start_date"`
 	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
-	UserID     uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_user_service_unique"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index;index:idx_tenant_user_service"`
+
+	// Category and tags classify subscriptions for spending analysis, tags are normalized
+	Category string      `gorm:"type:varchar(100);not null;default:'';index"`
+	Tags     StringArray `gorm:"type:text[];not null;default:'{}';index:,type:gin"`
+
+	// ServiceID references the catalog entry of the service, catalog entries in use cannot be deleted
+	ServiceID *uuid.UUID `gorm:"type:uuid;index"`
+	Service   *Service   `gorm:"constraint:OnDelete:RESTRICT"`
+
+	// Set when the subscription was cancelled, the end date is then its effective end
+	CancelledAt        *time.Time
+	CancellationReason *string `gorm:"type:text"`
+
+	// Pauses are replaced as a whole when the subscription is updated
+	Pauses []SubscriptionPause `gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE"`
+
+	// Split is the rule dividing the price between the members, empty when the subscription is not shared.
+	// Members are replaced as a whole like pauses.
+	Split   string               `gorm:"type:varchar(16);not null;default:''"`
+	Members []SubscriptionMember `gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE"`
+
+	// Discounts are replaced as a whole like pauses
+	Discounts []SubscriptionDiscount `gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index;index:idx_tenant_user_service,priority:1"`
 }
 
 // TableName specifies the table name
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SubscriptionDiscount represents the database model for a promotion reducing the price of a subscription
+type SubscriptionDiscount struct {
+	EndMonth *int `gorm:"check:end_month >= 1 AND end_month <= 12"`
+	EndYear  *int
+
+	// Kind is "percentage" or "fixed", Value is the percent or the monthly amount in minor units taken off the price
+	Kind  string `gorm:"type:varchar(16);not null"`
+	Value int64  `gorm:"type:bigint;not null;check:value > 0"`
+
+	StartMonth     int       `gorm:"not null;check:start_month >= 1 AND start_month <= 12"`
+	StartYear      int       `gorm:"not null"`
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	SubscriptionID uuid.UUID `gorm:"type:uuid;not null;index"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+}
+
+// TableName specifies the table name
+func (*SubscriptionDiscount) TableName() string {
+	return "subscription_discounts"
+}
+
+// BeforeCreate GORM hook
+func (d *SubscriptionDiscount) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
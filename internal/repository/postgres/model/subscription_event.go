@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SubscriptionEvent represents the database model for recorded subscription changes.
+// The subscription columns hold its state after the change, or before it for deletions.
+type SubscriptionEvent struct {
+	CreatedAt time.Time `gorm:"not null;index"`
+
+	EndMonth      *int
+	EndYear       *int
+	TrialEndMonth *int
+	TrialEndYear  *int
+
+	Type        string `gorm:"type:varchar(32);not null"`
+	ServiceName string `gorm:"type:varchar(255);not null"`
+	Price       int64  `gorm:"type:bigint;not null"`
+	Currency    string `gorm:"type:varchar(3);not null;default:'RUB'"`
+
+	StartMonth     int       `gorm:"not null"`
+	StartYear      int       `gorm:"not null"`
+	ID             int64     `gorm:"primaryKey;autoIncrement;index:idx_subscription_events_tenant_id,priority:2"`
+	SubscriptionID uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index:idx_subscription_events_tenant_id,priority:1"`
+}
+
+// TableName specifies the table name
+func (*SubscriptionEvent) TableName() string {
+	return "subscription_events"
+}
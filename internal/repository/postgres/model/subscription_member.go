@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// SubscriptionMember represents the database model for a user sharing the cost of a subscription
+type SubscriptionMember struct {
+	SubscriptionID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID         uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+
+	// Position keeps the order of the members, the remainder of a split goes to the first ones
+	Position int   `gorm:"not null"`
+	Share    int64 `gorm:"type:bigint;not null;default:0;check:share >= 0"`
+	// Amount is the monthly part of the price in minor units the member bears
+	Amount int64 `gorm:"type:bigint;not null;check:amount >= 0"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+}
+
+// TableName specifies the table name
+func (*SubscriptionMember) TableName() string {
+	return "subscription_members"
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SubscriptionPause represents the database model for an interval in which a subscription is paused
+type SubscriptionPause struct {
+	EndMonth *int `gorm:"check:end_month >= 1 AND end_month <= 12"`
+	EndYear  *int
+
+	StartMonth     int       `gorm:"not null;check:start_month >= 1 AND start_month <= 12"`
+	StartYear      int       `gorm:"not null"`
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	SubscriptionID uuid.UUID `gorm:"type:uuid;not null;index"`
+
+	// TenantID is assigned and enforced by the repository tenant scope
+	TenantID uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+}
+
+// TableName specifies the table name
+func (*SubscriptionPause) TableName() string {
+	return "subscription_pauses"
+}
+
+// BeforeCreate GORM hook
+func (p *SubscriptionPause) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -2,13 +2,14 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
-	"github.com/jackc/pgx/v5/pgconn"
 	"subscription/core/domain"
 	"subscription/internal/repository/postgres/model"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"subscription/core/ports"
 	"subscription/internal/logger"
@@ -16,6 +17,95 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// chargedFromSQL is the first charged month of a subscription as year * 12 + month, trial months are free
+	chargedFromSQL = "COALESCE(subscriptions.trial_end_year * 12 + subscriptions.trial_end_month + 1, " +
+		"subscriptions.start_year * 12 + subscriptions.start_month)"
+
+	// chargedUntilSQL is the month after the last charged month of a subscription within [@from, @to)
+	chargedUntilSQL = "LEAST(COALESCE(subscriptions.end_year * 12 + subscriptions.end_month + 1, @to), @to)"
+
+	// pausedMonthsSQL counts the months within [@from, @to) a subscription would be charged for but is paused in,
+	// pauses of a subscription do not overlap
+	pausedMonthsSQL = `COALESCE((
+		SELECT SUM(GREATEST(
+			LEAST(COALESCE(p.end_year * 12 + p.end_month + 1, @to), ` + chargedUntilSQL + `) -
+			GREATEST(p.start_year * 12 + p.start_month, ` + chargedFromSQL + `, @from),
+			0))
+		FROM subscription_pauses p
+		WHERE p.subscription_id = subscriptions.id
+	), 0)`
+
+	// chargedMonthsSQL counts the months within [@from, @to) a subscription is charged for, trial months are free,
+	// charging starts after them, and paused months are not charged
+	chargedMonthsSQL = "(GREATEST(" + chargedUntilSQL + " - GREATEST(" + chargedFromSQL + ", @from), 0) - " +
+		pausedMonthsSQL + ")"
+
+	// costSQL is the cost of a subscription within [@from, @to) charged to its payer before discounts,
+	// it is computed in numeric so that long periods of high prices cannot overflow bigint
+	costSQL = chargedMonthsSQL + " * subscriptions.price::numeric"
+
+	// discountFromSQL and discountUntilSQL bound the charged months within [@from, @to) a discount covers
+	discountFromSQL  = "GREATEST(d.start_year * 12 + d.start_month, " + chargedFromSQL + ", @from)"
+	discountUntilSQL = "LEAST(COALESCE(d.end_year * 12 + d.end_month + 1, @to), " + chargedUntilSQL + ")"
+
+	// discountAmountSQL is the monthly reduction of the price by a discount, it never exceeds the price
+	discountAmountSQL = `CASE d.kind WHEN 'percentage' THEN TRUNC(subscriptions.price::numeric * d.value / 100)
+		ELSE LEAST(d.value, subscriptions.price)::numeric END`
+
+	// discountSQL is the amount taken off the cost of a subscription within [@from, @to) by its discounts,
+	// discounts of a subscription do not overlap and paused months they cover are not charged anyway
+	discountSQL = `COALESCE((
+		SELECT SUM((GREATEST(` + discountUntilSQL + ` - ` + discountFromSQL + `, 0) - COALESCE((
+			SELECT SUM(GREATEST(
+				LEAST(COALESCE(p.end_year * 12 + p.end_month + 1, @to), ` + discountUntilSQL + `) -
+				GREATEST(p.start_year * 12 + p.start_month, ` + discountFromSQL + `),
+				0))
+			FROM subscription_pauses p
+			WHERE p.subscription_id = subscriptions.id
+		), 0)) * ` + discountAmountSQL + `)
+		FROM subscription_discounts d
+		WHERE d.subscription_id = subscriptions.id
+	), 0)`
+
+	// sharedWithSQL checks if a subscription is shared with any of the users in @users
+	sharedWithSQL = `EXISTS (
+		SELECT 1 FROM subscription_members m
+		WHERE m.subscription_id = subscriptions.id AND m.user_id IN @users
+	)`
+
+	// sharedPriceSQL is the part of the monthly price attributed to the users in @users,
+	// the shares of the members among them for shared subscriptions and the price otherwise
+	sharedPriceSQL = `CASE WHEN subscriptions.split = '' THEN subscriptions.price::numeric ELSE COALESCE((
+		SELECT SUM(m.amount) FROM subscription_members m
+		WHERE m.subscription_id = subscriptions.id AND m.user_id IN @users
+	), 0) END`
+
+	// shareCostSQL is the cost of a subscription within [@from, @to) attributed to the users in @users
+	// before discounts
+	shareCostSQL = chargedMonthsSQL + " * " + sharedPriceSQL
+
+	// shareDiscountSQL is the part of the discount attributed to the users in @users in proportion to their share,
+	// rounded down to a minor unit
+	shareDiscountSQL = "FLOOR(" + discountSQL + " * " + sharedPriceSQL + " / subscriptions.price)"
+
+	// discountInSQL is the reduction of the price in the month given as year * 12 + month
+	discountInSQL = `COALESCE((
+		SELECT SUM(` + discountAmountSQL + `) FROM subscription_discounts d
+		WHERE d.subscription_id = subscriptions.id
+			AND d.start_year * 12 + d.start_month <= ?
+			AND (d.end_year IS NULL OR d.end_year * 12 + d.end_month >= ?)
+	), 0)`
+
+	// pausedInSQL checks if a subscription is paused in the month given as year * 12 + month
+	pausedInSQL = `EXISTS (
+		SELECT 1 FROM subscription_pauses p
+		WHERE p.subscription_id = subscriptions.id
+			AND p.start_year * 12 + p.start_month <= ?
+			AND (p.end_year IS NULL OR p.end_year * 12 + p.end_month >= ?)
+	)`
+)
+
 type SubscriptionRepository struct {
 	db *gorm.DB
 }
@@ -31,15 +121,29 @@ func (r *SubscriptionRepository) Create(ctx context.Context, subscription *domai
 		return uuid.Nil, err
 	}
 
-	result := r.db.WithContext(ctx).Create(dbSub)
-	if result.Error != nil {
-		logger.Error().Err(result.Error)
-
-		var pgErr *pgconn.PgError
-		if errors.As(result.Error, &pgErr) && pgErr.Code == "23505" {
-			return uuid.Nil, domain.ErrDuplicateSubscription
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkOverlap(ctx, tx, subscription); err != nil {
+			return err
 		}
-
+		if err := tx.Omit(clause.Associations).Create(dbSub).Error; err != nil {
+			return err
+		}
+		if err := replacePauses(tx, dbSub); err != nil {
+			return err
+		}
+		if err := replaceMembers(tx, dbSub); err != nil {
+			return err
+		}
+		if err := replaceDiscounts(tx, dbSub); err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, domain.SubscriptionCreated, dbSub)
+	})
+	if domain.IsOverlapError(err) {
+		return uuid.Nil, err
+	}
+	if err != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(err).Msg("Failed to create subscription")
 		return uuid.Nil, domain.ErrInternal
 	}
 
@@ -49,7 +153,7 @@ func (r *SubscriptionRepository) Create(ctx context.Context, subscription *domai
 // GetByID returns subscription by ID
 func (r *SubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Subscription, error) {
 	var dbSub model.Subscription
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&dbSub)
+	result := preloadAssociations(r.db.WithContext(ctx)).Where("id = ?", id).First(&dbSub)
 	if result.Error != nil {
 		logger.Error().Err(result.Error)
 
@@ -65,7 +169,7 @@ func (r *SubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*do
 
 // List returns subscriptions with filtering and pagination
 func (r *SubscriptionRepository) List(ctx context.Context, filter ports.SubscriptionFilter, pagination ports.Pagination) ([]*domain.Subscription, *ports.PaginationMetadata, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	query := r.db.WithContext(ctx).Model(&model.Subscription{})
 
@@ -78,6 +182,21 @@ func (r *SubscriptionRepository) List(ctx context.Context, filter ports.Subscrip
 	if filter.StartDateFrom != nil {
 		query = applyDateFilter(query, *filter.StartDateFrom, filter.StartDateTo)
 	}
+	if filter.TrialEndFrom != nil || filter.TrialEndTo != nil {
+		query = applyTrialEndFilter(query, filter.TrialEndFrom, filter.TrialEndTo)
+	}
+	if len(filter.Statuses) > 0 {
+		now := time.Now()
+		query = applyStatusFilter(query, filter.Statuses, now.Year()*12+int(now.Month()))
+	}
+	query = applyClassificationFilter(query, filter.Categories, filter.Tags)
+	if filter.EndDateNull != nil {
+		if *filter.EndDateNull {
+			query = query.Where("end_year IS NULL")
+		} else {
+			query = query.Where("end_year IS NOT NULL")
+		}
+	}
 
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
@@ -85,8 +204,9 @@ func (r *SubscriptionRepository) List(ctx context.Context, filter ports.Subscrip
 		return nil, nil, domain.ErrInternal
 	}
 
+	// A stable order keeps pages consistent when walking through all of them
 	offset := (pagination.Page - 1) * pagination.Limit
-	query = applyPagination(query, offset, pagination.Limit)
+	query = preloadAssociations(applyPagination(query.Order("created_at, id"), offset, pagination.Limit))
 
 	var dbSubs []model.Subscription
 	result := query.Find(&dbSubs)
@@ -120,7 +240,7 @@ func (r *SubscriptionRepository) List(ctx context.Context, filter ports.Subscrip
 
 // Update renews subscription
 func (r *SubscriptionRepository) Update(ctx context.Context, subscription *domain.Subscription) error {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	dbSub, err := ToDBModel(subscription)
 	if err != nil {
@@ -128,9 +248,43 @@ func (r *SubscriptionRepository) Update(ctx context.Context, subscription *domai
 		return err
 	}
 
-	result := r.db.WithContext(ctx).Save(dbSub)
-	if result.Error != nil {
-		log.Error().Err(result.Error).Str("subscription_id", subscription.ID.String()).Msg("Failed to update subscription")
+	// Updates (unlike Save) never falls back to an insert, so a record hidden by the tenant scope cannot be overwritten
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkOverlap(ctx, tx, subscription); err != nil {
+			return err
+		}
+
+		result := tx.Model(dbSub).
+			Select("*").
+			Omit("id", "created_at", "tenant_id", clause.Associations).
+			Updates(dbSub)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrSubscriptionNotFound
+		}
+		if err := replacePauses(tx, dbSub); err != nil {
+			return err
+		}
+		if err := replaceMembers(tx, dbSub); err != nil {
+			return err
+		}
+		if err := replaceDiscounts(tx, dbSub); err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, domain.SubscriptionUpdated, dbSub)
+	})
+	if errors.Is(err, domain.ErrSubscriptionNotFound) {
+		log.Debug().Str("subscription_id", subscription.ID.String()).Msg("Subscription not found for update")
+		return err
+	}
+	if domain.IsOverlapError(err) {
+		log.Debug().Err(err).Str("subscription_id", subscription.ID.String()).Msg("Updated subscription overlaps another one")
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", subscription.ID.String()).Msg("Failed to update subscription")
 		return domain.ErrInternal
 	}
 
@@ -140,19 +294,66 @@ func (r *SubscriptionRepository) Update(ctx context.Context, subscription *domai
 
 // PartialUpdate partially renews subscription
 func (r *SubscriptionRepository) PartialUpdate(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
-	log := logger.WithRequestID(getRequestID(ctx))
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	updates["updated_at"] = time.Now()
-
-	result := r.db.WithContext(ctx).Model(&model.Subscription{}).Where("id = ?", id).Updates(updates)
-	if result.Error != nil {
-		log.Error().Err(result.Error).Str("subscription_id", id.String()).Msg("Failed to partially update subscription")
-		return domain.ErrInternal
+	if tags, ok := updates["tags"].([]string); ok {
+		updates["tags"] = model.StringArray(tags)
 	}
+	// Members and discounts are stored in their own tables, the split is updated with the members
+	members, replacingMembers := updates["members"].([]domain.Member)
+	delete(updates, "members")
+	discounts, replacingDiscounts := updates["discounts"].([]domain.Discount)
+	delete(updates, "discounts")
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Subscription{}).Where("id = ?", id).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrSubscriptionNotFound
+		}
 
-	if result.RowsAffected == 0 {
+		var dbSub model.Subscription
+		if err := tx.Where("id = ?", id).First(&dbSub).Error; err != nil {
+			return err
+		}
+		if replacingMembers {
+			dbSub.Members = MembersToDBModel(id, members)
+			if err := replaceMembers(tx, &dbSub); err != nil {
+				return err
+			}
+		}
+		if replacingDiscounts {
+			var err error
+			if dbSub.Discounts, err = DiscountsToDBModel(id, discounts); err != nil {
+				return err
+			}
+			if err := replaceDiscounts(tx, &dbSub); err != nil {
+				return err
+			}
+		}
+		subscription, err := ToDomain(&dbSub)
+		if err != nil {
+			return err
+		}
+		if err = checkOverlap(ctx, tx, subscription); err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, domain.SubscriptionUpdated, &dbSub)
+	})
+	if errors.Is(err, domain.ErrSubscriptionNotFound) {
 		log.Debug().Str("subscription_id", id.String()).Msg("Subscription not found for partial update")
-		return domain.ErrSubscriptionNotFound
+		return err
+	}
+	if domain.IsOverlapError(err) {
+		log.Debug().Err(err).Str("subscription_id", id.String()).Msg("Updated subscription overlaps another one")
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to partially update subscription")
+		return domain.ErrInternal
 	}
 
 	log.Info().Str("subscription_id", id.String()).Msg("Subscription partially updated successfully")
@@ -161,133 +362,318 @@ func (r *SubscriptionRepository) PartialUpdate(ctx context.Context, id uuid.UUID
 
 // Delete deletes subscription
 func (r *SubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	log := logger.WithRequestID(getRequestID(ctx))
-
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Subscription{})
-	if result.Error != nil {
-		log.Error().Err(result.Error).Str("subscription_id", id.String()).Msg("Failed to delete subscription")
-		return domain.ErrInternal
-	}
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The event keeps the last state of the subscription
+		var dbSub model.Subscription
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&dbSub).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrSubscriptionNotFound
+			}
+			return err
+		}
 
-	if result.RowsAffected == 0 {
+		if err := tx.Where("id = ?", id).Delete(&model.Subscription{}).Error; err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, domain.SubscriptionDeleted, &dbSub)
+	})
+	if errors.Is(err, domain.ErrSubscriptionNotFound) {
 		log.Debug().Str("subscription_id", id.String()).Msg("Subscription not found for deletion")
-		return domain.ErrSubscriptionNotFound
+		return err
+	}
+	if err != nil {
+		log.Error().Err(err).Str("subscription_id", id.String()).Msg("Failed to delete subscription")
+		return domain.ErrInternal
 	}
 
 	log.Info().Str("subscription_id", id.String()).Msg("Subscription deleted successfully")
 	return nil
 }
 
-// GetTotalCost calculates the total cost of subscriptions
-func (r *SubscriptionRepository) GetTotalCost(ctx context.Context, startDate, endDate string, filter ports.SubscriptionFilter) (int, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+// GetTotalCost calculates the total cost of subscriptions, discounts are taken off the total.
+// The net cost is the total without the tax, so both always add up.
+func (r *SubscriptionRepository) GetTotalCost(ctx context.Context, startDate, endDate string, filter ports.SubscriptionFilter) (*ports.CostTotal, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
 	startMonth, startYear, err := parseMMYYYY(startDate)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	endMonth, endYear, err := parseMMYYYY(endDate)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	startMonths := startYear*12 + startMonth
 	endMonths := endYear*12 + endMonth + 1
 
+	cost, discount, users := costOf(filter)
 	query := r.db.WithContext(ctx).Model(&model.Subscription{}).
-		Select(`
-			COALESCE(SUM(
-					CASE
-						WHEN (end_year IS NOT NULL AND ? > end_year * 12 + end_month) OR (? < start_year * 12 + start_month)
-							THEN 0
-						ELSE
-							(
-								LEAST(COALESCE(end_year * 12 + end_month + 1, ?), ?::bigint) -
-								GREATEST(start_year * 12 + start_month, ?::bigint)
-								) * price
-						END
-			), 0) AS total_cost`,
-			startMonths, endMonths, endMonths, endMonths, startMonths)
+		Select(costTotalsSQL(cost, discount), sql.Named("from", startMonths), sql.Named("to", endMonths), users)
 
-	query = buildWhereINCondition(query, "user_id", filter.UserIDs)
+	query = applyCostUserFilter(query, filter)
 	query = buildWhereINCondition(query, "service_name", filter.ServiceNames)
-
-	var totalCost int
-	result := query.Scan(&totalCost)
+	query = applyClassificationFilter(query, filter.Categories, filter.Tags)
+	query = applyCurrencyFilter(query, filter.Currency)
+
+	var total ports.CostTotal
+	result := query.Scan(&total)
+	if isOutOfRange(result.Error) {
+		log.Warn().Err(result.Error).Msg("Total cost is out of range")
+		return nil, domain.ErrAmountOverflow
+	}
 	if result.Error != nil {
 		log.Error().Err(result.Error).Msg("Failed to calculate total cost")
-		return 0, domain.ErrInternal
+		return nil, domain.ErrInternal
 	}
+	total.NetCost = total.TotalCost - total.Tax
 
-	log.Debug().Int("total_cost", totalCost).Msg("Total cost calculated successfully")
-	return totalCost, nil
+	log.Debug().Int64("total_cost", total.TotalCost).Int64("tax", total.Tax).Int64("discount", total.Discount).
+		Msg("Total cost calculated successfully")
+	return &total, nil
 }
 
-// SubscriptionExists checks for the existence of a subscription
-func (r *SubscriptionRepository) SubscriptionExists(ctx context.Context, userID uuid.UUID, serviceName string) (bool, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+// GetCostBreakdown calculates the cost of subscriptions per category or tag after discounts.
+// Untagged subscriptions are grouped under an empty tag, groups without cost are left out.
+func (r *SubscriptionRepository) GetCostBreakdown(ctx context.Context, startDate, endDate string, filter ports.SubscriptionFilter, groupBy ports.CostGroup) ([]ports.CostBreakdownItem, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
 
-	var count int64
-	result := r.db.WithContext(ctx).Model(&model.Subscription{}).
-		Where("user_id = ? AND service_name = ?", userID, serviceName).
-		Count(&count)
+	startMonth, startYear, err := parseMMYYYY(startDate)
+	if err != nil {
+		return nil, err
+	}
 
-	if result.Error != nil {
-		log.Error().Err(result.Error).
-			Str("user_id", userID.String()).
-			Str("service_name", serviceName).
-			Msg("Failed to check subscription existence")
-		return false, domain.ErrInternal
+	endMonth, endYear, err := parseMMYYYY(endDate)
+	if err != nil {
+		return nil, err
 	}
 
-	exists := count > 0
-	log.Debug().
-		Str("user_id", userID.String()).
-		Str("service_name", serviceName).
-		Bool("exists", exists).
-		Msg("Subscription existence checked")
+	startMonths := startYear*12 + startMonth
+	endMonths := endYear*12 + endMonth + 1
+
+	query := r.db.WithContext(ctx).Model(&model.Subscription{})
+	key := "subscriptions.category"
+	if groupBy == ports.CostByTag {
+		query = query.Joins("LEFT JOIN LATERAL unnest(subscriptions.tags) AS t(tag) ON true")
+		key = "COALESCE(t.tag, '')"
+	}
 
-	return exists, nil
+	cost, discount, users := costOf(filter)
+	from, to := sql.Named("from", startMonths), sql.Named("to", endMonths)
+	query = query.
+		Select(key+" AS key, "+costTotalsSQL(cost, discount), from, to, users).
+		Group(key).
+		Having("SUM("+cost+") > 0", from, to, users).
+		Order("total_cost DESC, key")
+
+	query = applyCostUserFilter(query, filter)
+	query = buildWhereINCondition(query, "service_name", filter.ServiceNames)
+	query = applyClassificationFilter(query, filter.Categories, filter.Tags)
+	query = applyCurrencyFilter(query, filter.Currency)
+
+	var breakdown []ports.CostBreakdownItem
+	if err := query.Scan(&breakdown).Error; err != nil {
+		if isOutOfRange(err) {
+			log.Warn().Err(err).Str("group_by", string(groupBy)).Msg("Cost breakdown is out of range")
+			return nil, domain.ErrAmountOverflow
+		}
+		log.Error().Err(err).Str("group_by", string(groupBy)).Msg("Failed to calculate cost breakdown")
+		return nil, domain.ErrInternal
+	}
+	for i := range breakdown {
+		breakdown[i].NetCost = breakdown[i].TotalCost - breakdown[i].Tax
+	}
+
+	log.Debug().Int("groups", len(breakdown)).Msg("Cost breakdown calculated successfully")
+	return breakdown, nil
 }
 
-// GetByUserAndService returns subscription by user ID and service name
-func (r *SubscriptionRepository) GetByUserAndService(ctx context.Context, userID uuid.UUID, serviceName string) (*domain.Subscription, error) {
-	log := logger.WithRequestID(getRequestID(ctx))
+// GetActiveStats returns the number and total price after discounts per currency of subscriptions active
+// in the given month
+func (r *SubscriptionRepository) GetActiveStats(ctx context.Context, month, year int) (*ports.SubscriptionStats, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	months := year*12 + month
+	active := func() *gorm.DB {
+		return r.db.WithContext(ctx).Model(&model.Subscription{}).
+			Where("start_year * 12 + start_month <= ?", months).
+			Where("end_year IS NULL OR end_year * 12 + end_month >= ?", months).
+			Where("NOT "+pausedInSQL, months, months)
+	}
 
-	var dbSub model.Subscription
-	result := r.db.WithContext(ctx).
-		Where("user_id = ? AND service_name = ?", userID, serviceName).
-		First(&dbSub)
+	var stats ports.SubscriptionStats
+	result := active().
+		Select(`COUNT(*) AS active_count, COUNT(*) FILTER (WHERE `+chargedFromSQL+` > ?) AS trial_count`, months).
+		Scan(&stats)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("Failed to calculate active subscription stats")
+		return nil, domain.ErrInternal
+	}
 
+	var spend []struct {
+		Currency string
+		Amount   int64
+	}
+	result = active().
+		Select(`currency, SUM(`+grossSQL("(price - "+discountInSQL+")::numeric")+`) AS amount`, months, months, months, months).
+		Where(chargedFromSQL+" <= ?", months).
+		Group("currency").
+		Order("currency").
+		Scan(&spend)
 	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			log.Debug().
-				Str("user_id", userID.String()).
-				Str("service_name", serviceName).
-				Msg("Subscription not found")
-			return nil, domain.ErrSubscriptionNotFound
-		}
-		log.Error().Err(result.Error).
-			Str("user_id", userID.String()).
-			Str("service_name", serviceName).
-			Msg("Failed to get subscription by user and service")
+		log.Error().Err(result.Error).Msg("Failed to calculate monthly spend")
+		return nil, domain.ErrInternal
+	}
+
+	for _, s := range spend {
+		stats.MonthlySpend = append(stats.MonthlySpend, domain.NewMoney(s.Amount, domain.Currency(s.Currency)))
+	}
+	return &stats, nil
+}
+
+// ListEvents returns subscription events after the given ID
+func (r *SubscriptionRepository) ListEvents(ctx context.Context, afterID int64, filter ports.SubscriptionEventFilter, limit int) ([]*domain.SubscriptionEvent, error) {
+	log := logger.WithRequestContext(ctx, getRequestID(ctx))
+
+	query := r.db.WithContext(ctx).Model(&model.SubscriptionEvent{}).Where("id > ?", afterID)
+	query = buildWhereINCondition(query, "user_id", filter.UserIDs)
+	query = buildWhereINCondition(query, "service_name", filter.ServiceNames)
+
+	var dbEvents []model.SubscriptionEvent
+	if err := query.Order("id").Limit(limit).Find(&dbEvents).Error; err != nil {
+		log.Error().Err(err).Int64("after_id", afterID).Msg("Failed to list subscription events")
 		return nil, domain.ErrInternal
 	}
 
-	domainSub, err := ToDomain(&dbSub)
+	events := make([]*domain.SubscriptionEvent, len(dbEvents))
+	for i := range dbEvents {
+		event, err := SubscriptionEventToDomain(&dbEvents[i])
+		if err != nil {
+			log.Error().Err(err).Int64("event_id", dbEvents[i].ID).Msg("Failed to convert DB model to domain model")
+			return nil, err
+		}
+		events[i] = event
+	}
+
+	return events, nil
+}
+
+// LastEventID returns the ID of the latest subscription event
+func (r *SubscriptionRepository) LastEventID(ctx context.Context) (int64, error) {
+	var lastID int64
+	result := r.db.WithContext(ctx).Model(&model.SubscriptionEvent{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&lastID)
+	if result.Error != nil {
+		logger.WithRequestContext(ctx, getRequestID(ctx)).Error().Err(result.Error).Msg("Failed to get last subscription event ID")
+		return 0, domain.ErrInternal
+	}
+
+	return lastID, nil
+}
+
+// DeleteEventsBefore removes subscription events of all tenants older than before
+func (r *SubscriptionRepository) DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ports.WithoutTenantScope(ctx)).
+		Where("created_at < ?", before).
+		Delete(&model.SubscriptionEvent{})
+	if result.Error != nil {
+		logger.Error().Err(result.Error).Msg("Failed to delete old subscription events")
+		return 0, domain.ErrInternal
+	}
+
+	return result.RowsAffected, nil
+}
+
+// checkOverlap fails with an overlap error when another subscription of the user to the same service
+// shares a month with subscription. The lock on the user and service is held until commit, so concurrent
+// changes of their subscriptions are checked one after another and always see each other.
+func checkOverlap(ctx context.Context, tx *gorm.DB, subscription *domain.Subscription) error {
+	tenantID, _ := ports.TenantFromContext(ctx)
+	lockKey := "subscriptions:" + tenantID.String() + ":" + subscription.UserID.String() + ":" + subscription.ServiceName
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", lockKey).Error; err != nil {
+		return err
+	}
+
+	var dbSubs []model.Subscription
+	err := tx.Where("user_id = ? AND service_name = ? AND id <> ?", subscription.UserID, subscription.ServiceName, subscription.ID).
+		Order("start_year, start_month").
+		Find(&dbSubs).Error
 	if err != nil {
-		log.Error().Err(err).
-			Str("user_id", userID.String()).
-			Str("service_name", serviceName).
-			Msg("Failed to convert DB model to domain model")
-		return nil, err
+		return err
+	}
+
+	for i := range dbSubs {
+		existing, err := ToDomain(&dbSubs[i])
+		if err != nil {
+			return err
+		}
+		if subscription.Overlaps(existing) {
+			return domain.NewOverlapError(existing)
+		}
+	}
+	return nil
+}
+
+// preloadAssociations loads the pauses and discounts of the queried subscriptions ordered by their start
+// and the members in their order
+func preloadAssociations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Pauses", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_year, start_month")
+		}).
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Discounts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_year, start_month")
+		})
+}
+
+// replacePauses stores the pauses of dbSub in place of the stored ones
+func replacePauses(tx *gorm.DB, dbSub *model.Subscription) error {
+	if err := tx.Where("subscription_id = ?", dbSub.ID).Delete(&model.SubscriptionPause{}).Error; err != nil {
+		return err
 	}
+	if len(dbSub.Pauses) == 0 {
+		return nil
+	}
+	return tx.Create(&dbSub.Pauses).Error
+}
 
-	log.Debug().
-		Str("user_id", userID.String()).
-		Str("service_name", serviceName).
-		Msg("Subscription found by user and service")
+// replaceMembers stores the members of dbSub in place of the stored ones
+func replaceMembers(tx *gorm.DB, dbSub *model.Subscription) error {
+	if err := tx.Where("subscription_id = ?", dbSub.ID).Delete(&model.SubscriptionMember{}).Error; err != nil {
+		return err
+	}
+	if len(dbSub.Members) == 0 {
+		return nil
+	}
+	return tx.Create(&dbSub.Members).Error
+}
+
+// replaceDiscounts stores the discounts of dbSub in place of the stored ones
+func replaceDiscounts(tx *gorm.DB, dbSub *model.Subscription) error {
+	if err := tx.Where("subscription_id = ?", dbSub.ID).Delete(&model.SubscriptionDiscount{}).Error; err != nil {
+		return err
+	}
+	if len(dbSub.Discounts) == 0 {
+		return nil
+	}
+	return tx.Create(&dbSub.Discounts).Error
+}
+
+// recordEvent stores the event of a change in the transaction of the change.
+// The tenant lock is held until commit, so events of a tenant are committed in ID order
+// and a stream resuming after an ID cannot miss an event committed later with a lower ID.
+func recordEvent(ctx context.Context, tx *gorm.DB, eventType domain.SubscriptionEventType, dbSub *model.Subscription) error {
+	tenantID, _ := ports.TenantFromContext(ctx)
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "subscription_events:"+tenantID.String()).Error; err != nil {
+		return err
+	}
 
-	return domainSub, nil
+	return tx.Create(SubscriptionEventToDBModel(eventType, dbSub)).Error
 }
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"reflect"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"subscription/core/domain"
+	"subscription/core/ports"
+)
+
+// tenantField is the model field that makes a table tenant-scoped
+const tenantField = "TenantID"
+
+// registerTenantScope installs GORM callbacks that restrict every query on
+// tenant-scoped models to the tenant of the principal in the statement context.
+// Statements without a tenant fail unless the context was created by ports.WithoutTenantScope.
+// Raw SQL (db.Raw / db.Exec) is not covered and must filter by tenant_id explicitly.
+func registerTenantScope(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	if err := callbacks.Create().Before("gorm:create").Register("tenant:create", assignTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("tenant:query", scopeTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("tenant:update", scopeTenantUpdate); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register("tenant:delete", scopeTenant); err != nil {
+		return err
+	}
+	return callbacks.Row().Before("gorm:row").Register("tenant:row", scopeTenant)
+}
+
+// statementTenant resolves the tenant field and tenant ID for the statement.
+// ok is false when the statement does not need tenant scoping.
+func statementTenant(db *gorm.DB) (field *schema.Field, tenantID uuid.UUID, ok bool) {
+	if db.Statement.Schema == nil {
+		return nil, uuid.Nil, false
+	}
+
+	field = db.Statement.Schema.LookUpField(tenantField)
+	if field == nil {
+		return nil, uuid.Nil, false
+	}
+
+	ctx := db.Statement.Context
+	if ports.IsTenantScopeDisabled(ctx) {
+		return nil, uuid.Nil, false
+	}
+
+	tenantID, found := ports.TenantFromContext(ctx)
+	if !found {
+		_ = db.AddError(domain.ErrTenantRequired)
+		return nil, uuid.Nil, false
+	}
+
+	return field, tenantID, true
+}
+
+// assignTenant sets the tenant of created records
+func assignTenant(db *gorm.DB) {
+	field, tenantID, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	setTenantField(db, field, tenantID)
+}
+
+// scopeTenant adds "tenant_id = ?" to the statement
+func scopeTenant(db *gorm.DB) {
+	field, tenantID, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: db.Statement.Table, Name: field.DBName}, Value: tenantID},
+	}})
+}
+
+// scopeTenantUpdate scopes updates and prevents them from moving records to another tenant
+func scopeTenantUpdate(db *gorm.DB) {
+	field, tenantID, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	if updates, isMap := db.Statement.Dest.(map[string]interface{}); isMap {
+		delete(updates, field.DBName)
+	} else {
+		setTenantField(db, field, tenantID)
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: db.Statement.Table, Name: field.DBName}, Value: tenantID},
+	}})
+}
+
+func setTenantField(db *gorm.DB, field *schema.Field, tenantID uuid.UUID) {
+	ctx := db.Statement.Context
+	value := db.Statement.ReflectValue
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := field.Set(ctx, reflect.Indirect(value.Index(i)), tenantID); err != nil {
+				_ = db.AddError(err)
+				return
+			}
+		}
+	case reflect.Struct:
+		if err := field.Set(ctx, value, tenantID); err != nil {
+			_ = db.AddError(err)
+		}
+	}
+}
@@ -2,17 +2,25 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"subscription/internal/logger"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 	"subscription/core/domain"
+	"subscription/core/ports"
+	"subscription/internal/repository/postgres/model"
 )
 
 const requestIdKey = "request_id"
 
+// numericValueOutOfRange is the SQLSTATE of values that do not fit their type
+const numericValueOutOfRange = "22003"
+
 // applyDateFilter applies date filtering to subscriptions
 func applyDateFilter(query *gorm.DB, startDateFrom string, startDateTo *string) *gorm.DB {
 	startMonth, startYear, err := parseMMYYYY(startDateFrom)
@@ -35,6 +43,129 @@ func applyDateFilter(query *gorm.DB, startDateFrom string, startDateTo *string)
 	return query
 }
 
+// applyTrialEndFilter keeps subscriptions with a trial ending within the inclusive months
+func applyTrialEndFilter(query *gorm.DB, trialEndFrom, trialEndTo *string) *gorm.DB {
+	query = query.Where("trial_end_year IS NOT NULL")
+
+	if trialEndFrom != nil {
+		if month, year, err := parseMMYYYY(*trialEndFrom); err == nil {
+			query = query.Where("trial_end_year * 12 + trial_end_month >= ?", year*12+month)
+		}
+	}
+	if trialEndTo != nil {
+		if month, year, err := parseMMYYYY(*trialEndTo); err == nil {
+			query = query.Where("trial_end_year * 12 + trial_end_month <= ?", year*12+month)
+		}
+	}
+
+	return query
+}
+
+// applyStatusFilter keeps subscriptions in any of the statuses in the month given as year * 12 + month,
+// the conditions follow domain.Subscription.StatusAt
+func applyStatusFilter(query *gorm.DB, statuses []domain.Status, month int) *gorm.DB {
+	const (
+		ended     = "end_year IS NOT NULL AND end_year * 12 + end_month < ?"
+		running   = "(end_year IS NULL OR end_year * 12 + end_month >= ?) AND cancelled_at IS NULL"
+		inTrial   = "trial_end_year IS NOT NULL AND trial_end_year * 12 + trial_end_month >= ?"
+		cancelled = "(end_year IS NULL OR end_year * 12 + end_month >= ?) AND cancelled_at IS NOT NULL"
+	)
+
+	conditions := make([]string, 0, len(statuses))
+	var args []interface{}
+	for _, status := range statuses {
+		switch status {
+		case domain.StatusEnded:
+			conditions = append(conditions, ended)
+			args = append(args, month)
+		case domain.StatusCancellationScheduled:
+			conditions = append(conditions, cancelled)
+			args = append(args, month)
+		case domain.StatusPaused:
+			conditions = append(conditions, running+" AND "+pausedInSQL)
+			args = append(args, month, month, month)
+		case domain.StatusTrial:
+			conditions = append(conditions, running+" AND NOT "+pausedInSQL+" AND "+inTrial)
+			args = append(args, month, month, month, month)
+		case domain.StatusActive:
+			conditions = append(conditions, running+" AND NOT "+pausedInSQL+" AND NOT ("+inTrial+")")
+			args = append(args, month, month, month, month)
+		}
+	}
+	if len(conditions) == 0 {
+		return query
+	}
+
+	return query.Where("(("+strings.Join(conditions, ") OR (")+"))", args...)
+}
+
+// applyClassificationFilter keeps subscriptions in any of the categories and with any of the tags
+func applyClassificationFilter(query *gorm.DB, categories, tags []string) *gorm.DB {
+	query = buildWhereINCondition(query, "category", categories)
+	if len(tags) > 0 {
+		query = query.Where("tags && ?::text[]", model.StringArray(tags))
+	}
+	return query
+}
+
+// applyCurrencyFilter keeps subscriptions priced in the currency when it is set
+func applyCurrencyFilter(query *gorm.DB, currency domain.Currency) *gorm.DB {
+	if currency == "" {
+		return query
+	}
+	return query.Where("subscriptions.currency = ?", string(currency))
+}
+
+// costOf returns the cost and discount expressions for the attribution of the filter and the @users they refer to.
+// Without a user filter every subscription is charged in full whatever the attribution is.
+func costOf(filter ports.SubscriptionFilter) (cost, discount string, users sql.NamedArg) {
+	users = sql.Named("users", filter.UserIDs)
+	if len(filter.UserIDs) == 0 || filter.Attribution == ports.AttributePayer {
+		return costSQL, discountSQL, users
+	}
+	return shareCostSQL, shareDiscountSQL, users
+}
+
+// costTotalsSQL selects total_cost, tax and discount of the cost and discount expressions of costOf,
+// total_cost is charged after discounts including tax. The sums are cast back to bigint,
+// totals beyond its range fail with numeric_value_out_of_range, see isOutOfRange.
+func costTotalsSQL(cost, discount string) string {
+	charged := "((" + cost + ") - (" + discount + "))"
+	return "COALESCE(SUM(" + grossSQL(charged) + "), 0)::bigint AS total_cost, " +
+		"COALESCE(SUM(" + taxSQL(charged) + "), 0)::bigint AS tax, COALESCE(SUM(" + discount + "), 0)::bigint AS discount"
+}
+
+// isOutOfRange checks if a query failed because a value does not fit its type
+func isOutOfRange(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == numericValueOutOfRange
+}
+
+// taxSQL is the tax on an amount charged for a subscription, contained in it for tax-inclusive prices
+// and added on top of it otherwise, rounded to a minor unit
+func taxSQL(charged string) string {
+	return "ROUND(" + charged + " * subscriptions.tax_rate / " +
+		"CASE WHEN subscriptions.tax_inclusive THEN 10000 + subscriptions.tax_rate ELSE 10000 END)"
+}
+
+// grossSQL is an amount charged for a subscription including tax
+func grossSQL(charged string) string {
+	return charged + " + CASE WHEN subscriptions.tax_inclusive THEN 0 ELSE " + taxSQL(charged) + " END"
+}
+
+// applyCostUserFilter keeps the subscriptions paid by the users of the filter, with share attribution
+// the shared ones only when any of the users is a member
+func applyCostUserFilter(query *gorm.DB, filter ports.SubscriptionFilter) *gorm.DB {
+	if len(filter.UserIDs) == 0 {
+		return query
+	}
+	if filter.Attribution == ports.AttributePayer {
+		return query.Where("subscriptions.user_id IN ?", filter.UserIDs)
+	}
+	return query.Where("((subscriptions.split = '' AND subscriptions.user_id IN @users) OR "+sharedWithSQL+")",
+		sql.Named("users", filter.UserIDs))
+}
+
 // parseMMYYYY parses a string of format MM-YYYY
 func parseMMYYYY(date string) (month, year int, err error) {
 	parts := strings.Split(date, "-")
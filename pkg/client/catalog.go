@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	api "subscription/internal/api/generated"
+)
+
+// Service is a catalog entry of a service users subscribe to
+type Service struct {
+	ID   uuid.UUID
+	Name string
+	// Aliases are other spellings matched to the name when subscriptions are created
+	Aliases  []string
+	Category string
+	// DefaultPrice is the monthly price of subscriptions created without one, nil when unset
+	DefaultPrice *Money
+	BillingCycle BillingCycle
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BillingCycle is how often a service charges its subscribers
+type BillingCycle string
+
+const (
+	BillingMonthly BillingCycle = "monthly"
+	BillingYearly  BillingCycle = "yearly"
+)
+
+// ServiceInput is the body of a catalog entry create request, zero values are not sent
+type ServiceInput struct {
+	Name         string
+	Aliases      []string
+	Category     string
+	DefaultPrice Money
+	BillingCycle BillingCycle
+}
+
+// ServicePatch changes only the set fields of a catalog entry
+type ServicePatch struct {
+	Name *string
+	// Aliases replace the current aliases when not nil
+	Aliases      []string
+	Category     *string
+	DefaultPrice *Money
+	// RemoveDefaultPrice removes the default price, DefaultPrice is ignored then
+	RemoveDefaultPrice bool
+	BillingCycle       *BillingCycle
+}
+
+// CreateService adds an entry to the service catalog, it requires an admin key
+func (c *Client) CreateService(ctx context.Context, input ServiceInput) (*Service, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.ServiceCreate{
+		Name:         input.Name,
+		Aliases:      input.Aliases,
+		Category:     optString(input.Category),
+		DefaultPrice: optPrice(input.DefaultPrice),
+	}
+	if input.BillingCycle != "" {
+		request.BillingCycle = api.NewOptBillingCycle(api.BillingCycle(input.BillingCycle))
+	}
+
+	res, err := c.api.ServicesPost(ctx, request)
+	if service, ok := res.(*api.Service); ok && err == nil {
+		return toService(service), nil
+	}
+	return nil, ex.err(err)
+}
+
+// GetService returns the catalog entry with the given ID
+func (c *Client) GetService(ctx context.Context, id uuid.UUID) (*Service, error) {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.ServicesIDGet(ctx, api.ServicesIDGetParams{ID: id})
+	if service, ok := res.(*api.Service); ok && err == nil {
+		return toService(service), nil
+	}
+	return nil, ex.err(err)
+}
+
+// ListServices returns the service catalog ordered by name, an empty category lists all entries
+func (c *Client) ListServices(ctx context.Context, category string) ([]Service, error) {
+	ctx, ex := begin(ctx)
+
+	params := api.ServicesGetParams{}
+	if category != "" {
+		params.Category = api.NewOptString(category)
+	}
+
+	list, err := c.api.ServicesGet(ctx, params)
+	if list == nil || err != nil {
+		return nil, ex.err(err)
+	}
+
+	services := make([]Service, len(list.Data))
+	for i := range list.Data {
+		services[i] = *toService(&list.Data[i])
+	}
+	return services, nil
+}
+
+// UpdateService updates the set fields of a catalog entry, it requires an admin key.
+// Subscriptions linked to the entry take over a changed name.
+func (c *Client) UpdateService(ctx context.Context, id uuid.UUID, patch ServicePatch) (*Service, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.ServicePatch{Aliases: patch.Aliases}
+	if patch.Name != nil {
+		request.Name = api.NewOptString(*patch.Name)
+	}
+	if patch.Category != nil {
+		request.Category = api.NewOptString(*patch.Category)
+	}
+	switch {
+	case patch.RemoveDefaultPrice:
+		request.DefaultPrice.SetToNull()
+	case patch.DefaultPrice != nil:
+		request.DefaultPrice = api.NewOptNilMoney(toMoneyInput(*patch.DefaultPrice))
+	}
+	if patch.BillingCycle != nil {
+		request.BillingCycle = api.NewOptBillingCycle(api.BillingCycle(*patch.BillingCycle))
+	}
+
+	res, err := c.api.ServicesIDPatch(ctx, request, api.ServicesIDPatchParams{ID: id})
+	if service, ok := res.(*api.Service); ok && err == nil {
+		return toService(service), nil
+	}
+	return nil, ex.err(err)
+}
+
+// DeleteService removes a catalog entry, it fails with a conflict while subscriptions are linked to it
+func (c *Client) DeleteService(ctx context.Context, id uuid.UUID) error {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.ServicesIDDelete(ctx, api.ServicesIDDeleteParams{ID: id})
+	if _, ok := res.(*api.ServicesIDDeleteNoContent); ok && err == nil {
+		return nil
+	}
+	return ex.err(err)
+}
+
+func toService(s *api.Service) *Service {
+	service := &Service{
+		ID:           s.ID.Value,
+		Name:         s.Name.Value,
+		Aliases:      s.Aliases,
+		Category:     s.Category.Value,
+		BillingCycle: BillingCycle(s.BillingCycle.Value),
+		CreatedAt:    s.CreatedAt.Value,
+		UpdatedAt:    s.UpdatedAt.Value,
+	}
+	if s.DefaultPrice.Set && !s.DefaultPrice.Null {
+		price := toMoney(s.DefaultPrice.Value)
+		service.DefaultPrice = &price
+	}
+	return service
+}
@@ -0,0 +1,141 @@
+// Package client is a Go SDK for the subscription API.
+//
+// It wraps the ogen generated client with plain Go types: identifiers are uuid.UUID and
+// MM-YYYY dates are time.Time values for the first day of the month. Requests are
+// authenticated with a bearer token or an API key, carry an X-Request-ID and, for
+// mutating methods, an Idempotency-Key, and are retried with backoff on 429 and 5xx.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	api "subscription/internal/api/generated"
+)
+
+// TokenSource returns the bearer token for a request, e.g. from a refreshing cache
+type TokenSource func(ctx context.Context) (string, error)
+
+// Client calls the subscription API
+type Client struct {
+	api *api.Client
+}
+
+type options struct {
+	httpClient  *http.Client
+	tokenSource TokenSource
+	apiKey      string
+	retry       RetryPolicy
+}
+
+// Option configures the Client
+type Option func(*options)
+
+// WithBearerToken authenticates requests with a static JWT
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.tokenSource = func(context.Context) (string, error) { return token, nil }
+	}
+}
+
+// WithTokenSource authenticates requests with JWTs returned by source
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithAPIKey authenticates requests with an API key issued via /admin/api-keys
+func WithAPIKey(key string) Option {
+	return func(o *options) {
+		o.apiKey = key
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests. Its transport is wrapped,
+// the client itself is not modified.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy, use NoRetry to disable retries
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// New creates a client for the API at serverURL, e.g. "https://subscriptions.example.com"
+func New(serverURL string, opts ...Option) (*Client, error) {
+	o := options{
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.tokenSource == nil && o.apiKey == "" {
+		return nil, errors.New("client: a bearer token or an API key is required")
+	}
+
+	httpClient := *o.httpClient
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next, retry: o.retry}
+
+	generated, err := api.NewClient(serverURL, security{tokenSource: o.tokenSource, apiKey: o.apiKey}, api.WithClient(&httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+
+	return &Client{api: generated}, nil
+}
+
+// security provides the configured credentials to the generated client
+type security struct {
+	tokenSource TokenSource
+	apiKey      string
+}
+
+func (s security) BearerAuth(ctx context.Context, _ api.OperationName) (api.BearerAuth, error) {
+	if s.tokenSource == nil {
+		return api.BearerAuth{}, ogenerrors.ErrSkipClientSecurity
+	}
+
+	token, err := s.tokenSource(ctx)
+	if err != nil {
+		return api.BearerAuth{}, err
+	}
+	return api.BearerAuth{Token: token}, nil
+}
+
+func (s security) ApiKeyAuth(context.Context, api.OperationName) (api.ApiKeyAuth, error) {
+	if s.apiKey == "" {
+		return api.ApiKeyAuth{}, ogenerrors.ErrSkipClientSecurity
+	}
+	return api.ApiKeyAuth{APIKey: s.apiKey}, nil
+}
+
+// begin prepares the context of an API call and returns the record of its HTTP exchange
+func begin(ctx context.Context) (context.Context, *exchange) {
+	ex := &exchange{
+		requestID:      RequestIDFromContext(ctx),
+		idempotencyKey: idempotencyKeyFromContext(ctx),
+	}
+	if ex.requestID == "" {
+		ex.requestID = newID()
+	}
+	if ex.idempotencyKey == "" {
+		ex.idempotencyKey = newID()
+	}
+	return context.WithValue(ctx, exchangeKey{}, ex), ex
+}
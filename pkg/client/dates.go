@@ -0,0 +1,52 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MonthLayout is the MM-YYYY layout of dates in the API
+const MonthLayout = "01-2006"
+
+// Month returns the first day of the month in UTC, the value used for API dates
+func Month(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// FormatMonth formats t as MM-YYYY
+func FormatMonth(t time.Time) string {
+	return t.Format(MonthLayout)
+}
+
+// ParseMonth parses an MM-YYYY date into the first day of the month in UTC
+func ParseMonth(value string) (time.Time, error) {
+	t, err := time.Parse(MonthLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid month %q, expected MM-YYYY", value)
+	}
+	return t, nil
+}
+
+// ParseIDs parses string identifiers into UUIDs
+func ParseIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q", value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// FormatIDs formats UUIDs as strings
+func FormatIDs(ids []uuid.UUID) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
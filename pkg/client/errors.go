@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIError is an error response of the API, the API reports errors as RFC 7807 problem details
+type APIError struct {
+	StatusCode int
+	// Type is the URI identifying the kind of problem, e.g. "/problems/validation-error"
+	Type  string
+	Title string
+	// Detail explains this occurrence of the problem
+	Detail string
+	// FieldErrors lists every invalid field of the request
+	FieldErrors []FieldError
+	// RequestID identifies the request in server logs
+	RequestID string
+	// RetryAfter is set when the request was rate limited
+	RetryAfter time.Duration
+}
+
+// FieldError describes why the value of a request field was rejected
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func (e *APIError) Error() string {
+	message := e.Detail
+	if message == "" {
+		message = e.Title
+	}
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	for _, fieldErr := range e.FieldErrors {
+		message += fmt.Sprintf("; %s %s", fieldErr.Field, fieldErr.Reason)
+	}
+	return fmt.Sprintf("subscription api: %d %s (request %s)", e.StatusCode, message, e.RequestID)
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is a 409 response
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsOverlap reports whether err rejects a subscription that overlaps another subscription
+// of the user to the same service
+func IsOverlap(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Type == "/problems/overlapping-subscription"
+}
+
+// IsValidationError reports whether err is a 400 response, FieldErrors names the rejected fields
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsRateLimited reports whether err is a 429 response that was not retried
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// err converts the outcome of a failed call, preferring the recorded error response
+// over the error of the generated client, which may only know the status code
+func (ex *exchange) err(err error) error {
+	if ex.statusCode < http.StatusBadRequest {
+		if err == nil {
+			err = fmt.Errorf("unexpected response with status %d", ex.statusCode)
+		}
+		return fmt.Errorf("subscription api: %w", err)
+	}
+
+	apiErr := &APIError{
+		StatusCode: ex.statusCode,
+		RequestID:  ex.requestID,
+		RetryAfter: retryAfter(ex.header),
+	}
+	if requestID := ex.header.Get(requestIDHeader); requestID != "" {
+		apiErr.RequestID = requestID
+	}
+
+	var body struct {
+		Type   string       `json:"type"`
+		Title  string       `json:"title"`
+		Detail string       `json:"detail"`
+		Errors []FieldError `json:"errors"`
+	}
+	if json.Unmarshal(ex.body, &body) == nil {
+		apiErr.Type = body.Type
+		apiErr.Title = body.Title
+		apiErr.Detail = body.Detail
+		apiErr.FieldErrors = body.Errors
+	}
+
+	return apiErr
+}
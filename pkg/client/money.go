@@ -0,0 +1,24 @@
+package client
+
+import api "subscription/internal/api/generated"
+
+// DefaultCurrency is sent for amounts given without a currency
+const DefaultCurrency = "RUB"
+
+// Money is an amount in minor units of a currency, e.g. 39900 RUB are 399.00 rubles
+type Money struct {
+	Amount int64
+	// Currency is an ISO 4217 code, DefaultCurrency when empty in requests
+	Currency string
+}
+
+func toMoney(m api.Money) Money {
+	return Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+func toMoneyInput(m Money) api.Money {
+	if m.Currency == "" {
+		m.Currency = DefaultCurrency
+	}
+	return api.Money{Amount: m.Amount, Currency: m.Currency}
+}
@@ -0,0 +1,747 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	api "subscription/internal/api/generated"
+)
+
+// maxPageLimit is the largest page size accepted by the API
+const maxPageLimit = 100
+
+// Subscription is a subscription of a user to a service.
+// StartDate, EndDate and TrialEnd are the first days of their months.
+type Subscription struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// ServiceID is the catalog entry of the service, nil when the name is not in the catalog
+	ServiceID   *uuid.UUID
+	ServiceName string
+	Price       Money
+	// TaxRate is the VAT rate in basis points, 2000 is 20%, TaxInclusive tells if Price includes it
+	TaxRate      int
+	TaxInclusive bool
+	StartDate    time.Time
+	EndDate      *time.Time
+	// TrialEnd is the last month of the free trial, trial months are not charged
+	TrialEnd *time.Time
+	// Pauses are ordered by start, paused months are not charged
+	Pauses []Pause
+	// Status is the lifecycle state in the current month
+	Status Status
+	// CancelledAt is set once the subscription is cancelled
+	CancelledAt        *time.Time
+	CancellationReason string
+	// Category is empty for unclassified subscriptions, tags are lower case
+	Category string
+	Tags     []string
+	// Split is empty when the subscription is not shared and the paying user bears the full price
+	Split   SplitRule
+	Members []Member
+	// Discounts are ordered by start, discounted months cost less
+	Discounts []Discount
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Status is the lifecycle state of a subscription in a month
+type Status string
+
+const (
+	StatusTrial                 Status = "trial"
+	StatusActive                Status = "active"
+	StatusPaused                Status = "paused"
+	StatusCancellationScheduled Status = "cancellation_scheduled"
+	StatusEnded                 Status = "ended"
+)
+
+// Pause is an interval of months in which a subscription is paused
+type Pause struct {
+	Start time.Time
+	// End is the last paused month, nil while paused until resumed
+	End *time.Time
+}
+
+// SplitRule decides how the price of a shared subscription is divided between its members
+type SplitRule string
+
+const (
+	SplitEqual SplitRule = "equal"
+	// SplitPercentage reads the shares of the members as percent adding up to 100
+	SplitPercentage SplitRule = "percentage"
+	// SplitFixed reads the shares of the members as monthly amounts adding up to the price
+	SplitFixed SplitRule = "fixed"
+)
+
+// Member is a user sharing the price of a subscription
+type Member struct {
+	UserID uuid.UUID
+	// Share is read according to the split rule and unused for an equal split
+	Share int64
+	// Amount is the monthly part of the price in minor units the member bears, it is ignored in requests
+	Amount int64
+}
+
+// DiscountKind decides how a discount reduces the monthly price
+type DiscountKind string
+
+const (
+	// DiscountPercentage takes Value percent off the price
+	DiscountPercentage DiscountKind = "percentage"
+	// DiscountFixed takes a monthly amount off the price, at most down to 0
+	DiscountFixed DiscountKind = "fixed"
+)
+
+// Discount is a promotion reducing the price of a subscription from the month of Start
+type Discount struct {
+	Kind DiscountKind
+	// Value is the percent for a percentage discount and the monthly amount in minor units for a fixed one
+	Value int64
+	Start time.Time
+	// End is the last discounted month, nil while the discount lasts until the subscription ends
+	End *time.Time
+}
+
+// SubscriptionInput is the body of create and full update requests
+type SubscriptionInput struct {
+	UserID      uuid.UUID
+	ServiceName string
+	// Price with amount 0 takes the default price of the catalog entry on create
+	Price Money
+	// TaxRate is the VAT rate in basis points, TaxInclusive nil takes the price as tax-inclusive
+	TaxRate      int
+	TaxInclusive *bool
+	StartDate    time.Time
+	EndDate      *time.Time
+	TrialEnd     *time.Time
+	// Category empty takes the category of the catalog entry
+	Category string
+	Tags     []string
+	// Members share the price by Split, the subscription is not shared without members
+	Split     SplitRule
+	Members   []Member
+	Discounts []Discount
+}
+
+// SubscriptionPatch changes only the set fields of a subscription
+type SubscriptionPatch struct {
+	ServiceName *string
+	Price       *Money
+	// TaxRate in basis points and TaxInclusive change the tax when set
+	TaxRate      *int
+	TaxInclusive *bool
+	EndDate      *time.Time
+	TrialEnd     *time.Time
+	// RemoveTrial removes the free trial, TrialEnd is ignored then
+	RemoveTrial bool
+	Category    *string
+	// Tags replace the current tags when not nil, an empty slice removes them
+	Tags  []string
+	Split *SplitRule
+	// Members replace the current members when not nil, an empty slice ends the sharing
+	Members []Member
+	// Discounts replace the current discounts when not nil, an empty slice removes them
+	Discounts []Discount
+}
+
+// EndingTrialsFilter selects subscriptions whose free trial ends soon
+type EndingTrialsFilter struct {
+	// WithinMonths is the number of months to look ahead, 1 selects the current month
+	WithinMonths int
+	UserIDs      []uuid.UUID
+	ServiceNames []string
+	Page         int
+	Limit        int
+}
+
+// ListFilter selects subscriptions to list, zero values are not applied
+type ListFilter struct {
+	UserIDs      []uuid.UUID
+	ServiceNames []string
+	// StartFrom and StartTo bound the start month, StartTo requires StartFrom
+	StartFrom *time.Time
+	StartTo   *time.Time
+	// Statuses selects subscriptions in any of the statuses in the current month
+	Statuses []Status
+	// Categories and Tags select subscriptions in any of the categories and with any of the tags
+	Categories []string
+	Tags       []string
+	Page       int
+	Limit      int
+}
+
+// SubscriptionPage is a page of listed subscriptions
+type SubscriptionPage struct {
+	Subscriptions []Subscription
+	Page          int
+	Limit         int
+	Total         int
+	Pages         int
+}
+
+// TotalCostQuery selects the period and subscriptions of a total cost calculation
+type TotalCostQuery struct {
+	From         time.Time
+	To           time.Time
+	UserIDs      []uuid.UUID
+	ServiceNames []string
+	Categories   []string
+	Tags         []string
+	// GroupBy adds a breakdown of the total cost when set
+	GroupBy CostGroup
+	// Attribution decides who bears the cost of shared subscriptions, AttributeShares when empty
+	Attribution CostAttribution
+	// Currency selects the subscriptions priced in it, DefaultCurrency when empty
+	Currency string
+}
+
+// CostAttribution decides how the cost of shared subscriptions is attributed to the queried users
+type CostAttribution string
+
+const (
+	// AttributeShares charges every member its share and the payer only its own share
+	AttributeShares CostAttribution = "share"
+	// AttributePayer charges the paying user the full price
+	AttributePayer CostAttribution = "payer"
+)
+
+// CostGroup selects what the total cost is broken down by
+type CostGroup string
+
+const (
+	CostByCategory CostGroup = "category"
+	// CostByTag counts a subscription under each of its tags, so the parts may add up to more than the total
+	CostByTag CostGroup = "tag"
+)
+
+// TotalCost is the cost of the selected subscriptions over the period
+type TotalCost struct {
+	// Total, NetCost, Tax and Discount are in minor units of Currency, Total includes Tax
+	Total        int64
+	NetCost      int64
+	Tax          int64
+	Currency     string
+	From         time.Time
+	To           time.Time
+	UserIDs      []uuid.UUID
+	ServiceNames []string
+	Categories   []string
+	Tags         []string
+	Attribution  CostAttribution
+	// Total is charged after Discount was taken off
+	Discount int64
+	// Breakdown is ordered by cost, highest first, and only set when grouping was requested
+	Breakdown []CostBreakdownItem
+}
+
+// CostBreakdownItem is the cost of the subscriptions in a category or with a tag,
+// Key is empty for unclassified subscriptions
+type CostBreakdownItem struct {
+	Key      string
+	Total    int64
+	NetCost  int64
+	Tax      int64
+	Discount int64
+}
+
+// CreateSubscription creates a subscription
+func (c *Client) CreateSubscription(ctx context.Context, input SubscriptionInput) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.SubscriptionsPost(ctx, &api.SubscriptionCreate{
+		UserID:       input.UserID,
+		ServiceName:  input.ServiceName,
+		Price:        optPrice(input.Price),
+		TaxRate:      optInt(input.TaxRate),
+		TaxInclusive: optBool(input.TaxInclusive),
+		StartDate:    FormatMonth(input.StartDate),
+		EndDate:      optMonth(input.EndDate),
+		TrialEndDate: optMonth(input.TrialEnd),
+		Category:     optString(input.Category),
+		Tags:         input.Tags,
+		Split:        optSplit(input.Split),
+		Members:      toMemberInputs(input.Members),
+		Discounts:    toDiscountInputs(input.Discounts),
+	})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// GetSubscription returns the subscription with the given ID
+func (c *Client) GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.SubscriptionsIDGet(ctx, api.SubscriptionsIDGetParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// ListSubscriptions returns a page of subscriptions matching the filter
+func (c *Client) ListSubscriptions(ctx context.Context, filter ListFilter) (*SubscriptionPage, error) {
+	ctx, ex := begin(ctx)
+
+	params := api.SubscriptionsGetParams{
+		UserIds:      filter.UserIDs,
+		ServiceNames: filter.ServiceNames,
+	}
+	if filter.StartFrom != nil {
+		params.StartDateFrom = api.NewOptString(FormatMonth(*filter.StartFrom))
+	}
+	if filter.StartTo != nil {
+		params.StartDateTo = api.NewOptString(FormatMonth(*filter.StartTo))
+	}
+	for _, status := range filter.Statuses {
+		params.Status = append(params.Status, api.SubscriptionStatus(status))
+	}
+	params.Categories = filter.Categories
+	params.Tags = filter.Tags
+	if filter.Page > 0 {
+		params.Page = api.NewOptInt(filter.Page)
+	}
+	if filter.Limit > 0 {
+		params.Limit = api.NewOptInt(filter.Limit)
+	}
+
+	res, err := c.api.SubscriptionsGet(ctx, params)
+	list, ok := res.(*api.SubscriptionsGetOK)
+	if !ok || err != nil {
+		return nil, ex.err(err)
+	}
+
+	return toSubscriptionPage(list.Data, list.Pagination.Value)
+}
+
+// PauseSubscription pauses a subscription from the month of start until the month of end,
+// or until it is resumed when end is nil
+func (c *Client) PauseSubscription(ctx context.Context, id uuid.UUID, start time.Time, end *time.Time) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.SubscriptionPauseRequest{StartDate: api.NewOptString(FormatMonth(start))}
+	if end != nil {
+		request.EndDate = api.NewOptString(FormatMonth(*end))
+	}
+
+	res, err := c.api.SubscriptionsIDPausePost(ctx, request, api.SubscriptionsIDPausePostParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// ResumeSubscription ends the pause covering the month of date, the subscription is charged again from then on
+func (c *Client) ResumeSubscription(ctx context.Context, id uuid.UUID, date time.Time) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.SubscriptionResumeRequest{Date: api.NewOptString(FormatMonth(date))}
+
+	res, err := c.api.SubscriptionsIDResumePost(ctx, request, api.SubscriptionsIDResumePostParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// CancelSubscription ends a subscription with the month of end, the last month it is charged for.
+// reason is optional.
+func (c *Client) CancelSubscription(ctx context.Context, id uuid.UUID, end time.Time, reason string) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.SubscriptionCancelRequest{EndDate: api.NewOptString(FormatMonth(end))}
+	if reason != "" {
+		request.Reason = api.NewOptString(reason)
+	}
+
+	res, err := c.api.SubscriptionsIDCancelPost(ctx, request, api.SubscriptionsIDCancelPostParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// ListEndingTrials returns a page of subscriptions whose free trial ends within filter.WithinMonths
+func (c *Client) ListEndingTrials(ctx context.Context, filter EndingTrialsFilter) (*SubscriptionPage, error) {
+	ctx, ex := begin(ctx)
+
+	params := api.SubscriptionsTrialsEndingGetParams{
+		UserIds:      filter.UserIDs,
+		ServiceNames: filter.ServiceNames,
+	}
+	if filter.WithinMonths > 0 {
+		params.WithinMonths = api.NewOptInt(filter.WithinMonths)
+	}
+	if filter.Page > 0 {
+		params.Page = api.NewOptInt(filter.Page)
+	}
+	if filter.Limit > 0 {
+		params.Limit = api.NewOptInt(filter.Limit)
+	}
+
+	res, err := c.api.SubscriptionsTrialsEndingGet(ctx, params)
+	list, ok := res.(*api.SubscriptionsTrialsEndingGetOK)
+	if !ok || err != nil {
+		return nil, ex.err(err)
+	}
+
+	return toSubscriptionPage(list.Data, list.Pagination.Value)
+}
+
+// ListAllSubscriptions walks through all pages of subscriptions matching the filter,
+// filter.Page is ignored
+func (c *Client) ListAllSubscriptions(ctx context.Context, filter ListFilter) ([]Subscription, error) {
+	var all []Subscription
+	if filter.Limit == 0 {
+		filter.Limit = maxPageLimit
+	}
+
+	for filter.Page = 1; ; filter.Page++ {
+		page, err := c.ListSubscriptions(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Subscriptions...)
+		if filter.Page >= page.Pages {
+			return all, nil
+		}
+	}
+}
+
+// UpdateSubscription replaces all fields of a subscription
+func (c *Client) UpdateSubscription(ctx context.Context, id uuid.UUID, input SubscriptionInput) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.SubscriptionsIDPut(ctx, &api.SubscriptionUpdate{
+		UserID:       input.UserID,
+		ServiceName:  input.ServiceName,
+		Price:        toMoneyInput(input.Price),
+		TaxRate:      optInt(input.TaxRate),
+		TaxInclusive: optBool(input.TaxInclusive),
+		StartDate:    FormatMonth(input.StartDate),
+		EndDate:      optMonth(input.EndDate),
+		TrialEndDate: optMonth(input.TrialEnd),
+		Category:     optString(input.Category),
+		Tags:         input.Tags,
+		Split:        optSplit(input.Split),
+		Members:      toMemberInputs(input.Members),
+		Discounts:    toDiscountInputs(input.Discounts),
+	}, api.SubscriptionsIDPutParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// PatchSubscription updates the set fields of a subscription
+func (c *Client) PatchSubscription(ctx context.Context, id uuid.UUID, patch SubscriptionPatch) (*Subscription, error) {
+	ctx, ex := begin(ctx)
+
+	request := &api.SubscriptionPatch{
+		Tags:      patch.Tags,
+		Members:   toMemberInputs(patch.Members),
+		Discounts: toDiscountInputs(patch.Discounts),
+	}
+	if patch.ServiceName != nil {
+		request.ServiceName = api.NewOptString(*patch.ServiceName)
+	}
+	if patch.Price != nil {
+		request.Price = api.NewOptMoney(toMoneyInput(*patch.Price))
+	}
+	if patch.TaxRate != nil {
+		request.TaxRate = api.NewOptInt(*patch.TaxRate)
+	}
+	request.TaxInclusive = optBool(patch.TaxInclusive)
+	if patch.EndDate != nil {
+		request.EndDate = api.NewOptNilString(FormatMonth(*patch.EndDate))
+	}
+	switch {
+	case patch.RemoveTrial:
+		request.TrialEndDate.SetToNull()
+	case patch.TrialEnd != nil:
+		request.TrialEndDate = api.NewOptNilString(FormatMonth(*patch.TrialEnd))
+	}
+	if patch.Category != nil {
+		request.Category = api.NewOptString(*patch.Category)
+	}
+	if patch.Split != nil {
+		request.Split = api.NewOptSplitRule(api.SplitRule(*patch.Split))
+	}
+
+	res, err := c.api.SubscriptionsIDPatch(ctx, request, api.SubscriptionsIDPatchParams{ID: id})
+	if subscription, ok := res.(*api.Subscription); ok && err == nil {
+		return toSubscription(subscription)
+	}
+	return nil, ex.err(err)
+}
+
+// EndSubscription sets the end month of a subscription
+func (c *Client) EndSubscription(ctx context.Context, id uuid.UUID, endDate time.Time) (*Subscription, error) {
+	return c.PatchSubscription(ctx, id, SubscriptionPatch{EndDate: &endDate})
+}
+
+// DeleteSubscription deletes a subscription
+func (c *Client) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	ctx, ex := begin(ctx)
+
+	res, err := c.api.SubscriptionsIDDelete(ctx, api.SubscriptionsIDDeleteParams{ID: id})
+	if _, ok := res.(*api.SubscriptionsIDDeleteNoContent); ok && err == nil {
+		return nil
+	}
+	return ex.err(err)
+}
+
+// TotalCost calculates the cost of the selected subscriptions over the period
+func (c *Client) TotalCost(ctx context.Context, query TotalCostQuery) (*TotalCost, error) {
+	ctx, ex := begin(ctx)
+
+	params := api.SubscriptionsSummaryTotalCostGetParams{
+		StartDate:    FormatMonth(query.From),
+		EndDate:      FormatMonth(query.To),
+		UserIds:      query.UserIDs,
+		ServiceNames: query.ServiceNames,
+		Categories:   query.Categories,
+		Tags:         query.Tags,
+	}
+	if query.GroupBy != "" {
+		params.GroupBy = api.NewOptSubscriptionsSummaryTotalCostGetGroupBy(api.SubscriptionsSummaryTotalCostGetGroupBy(query.GroupBy))
+	}
+	if query.Attribution != "" {
+		params.Attribution = api.NewOptSubscriptionsSummaryTotalCostGetAttribution(
+			api.SubscriptionsSummaryTotalCostGetAttribution(query.Attribution))
+	}
+	if query.Currency != "" {
+		params.Currency = api.NewOptString(query.Currency)
+	}
+
+	res, err := c.api.SubscriptionsSummaryTotalCostGet(ctx, params)
+	summary, ok := res.(*api.SubscriptionsSummaryTotalCostGetOK)
+	if !ok || err != nil {
+		return nil, ex.err(err)
+	}
+
+	result := &TotalCost{
+		Total:        summary.TotalCost.Value,
+		NetCost:      summary.NetCost.Value,
+		Tax:          summary.Tax.Value,
+		Discount:     summary.Discount.Value,
+		Currency:     summary.Currency.Value,
+		From:         query.From,
+		To:           query.To,
+		ServiceNames: summary.FilterCriteria.Value.ServiceNames,
+		Categories:   summary.FilterCriteria.Value.Categories,
+		Tags:         summary.FilterCriteria.Value.Tags,
+		Attribution:  CostAttribution(summary.FilterCriteria.Value.Attribution.Value),
+	}
+	for _, item := range summary.Breakdown {
+		result.Breakdown = append(result.Breakdown, CostBreakdownItem{
+			Key:      item.Key,
+			Total:    item.TotalCost,
+			NetCost:  item.NetCost,
+			Tax:      item.Tax,
+			Discount: item.Discount,
+		})
+	}
+	if result.UserIDs, err = ParseIDs(summary.FilterCriteria.Value.UserIds); err != nil {
+		return nil, fmt.Errorf("subscription api: filter_criteria: %w", err)
+	}
+	if period := summary.Period.Value; period.StartDate.Set && period.EndDate.Set {
+		if result.From, err = ParseMonth(period.StartDate.Value); err != nil {
+			return nil, fmt.Errorf("subscription api: period: %w", err)
+		}
+		if result.To, err = ParseMonth(period.EndDate.Value); err != nil {
+			return nil, fmt.Errorf("subscription api: period: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func toSubscription(s *api.Subscription) (*Subscription, error) {
+	startDate, err := ParseMonth(s.StartDate.Value)
+	if err != nil {
+		return nil, fmt.Errorf("subscription api: start_date: %w", err)
+	}
+
+	subscription := &Subscription{
+		ID:                 s.ID.Value,
+		UserID:             s.UserID.Value,
+		ServiceName:        s.ServiceName.Value,
+		Price:              toMoney(s.Price.Value),
+		TaxRate:            s.TaxRate.Value,
+		TaxInclusive:       s.TaxInclusive.Value,
+		StartDate:          startDate,
+		Status:             Status(s.Status.Value),
+		CreatedAt:          s.CreatedAt.Value,
+		UpdatedAt:          s.UpdatedAt.Value,
+		CancellationReason: s.CancellationReason.Value,
+		Category:           s.Category.Value,
+		Tags:               s.Tags,
+		Split:              SplitRule(s.Split.Value),
+	}
+	if s.ServiceID.Set && !s.ServiceID.Null {
+		serviceID := s.ServiceID.Value
+		subscription.ServiceID = &serviceID
+	}
+	if s.CancelledAt.Set && !s.CancelledAt.Null {
+		cancelledAt := s.CancelledAt.Value
+		subscription.CancelledAt = &cancelledAt
+	}
+
+	if s.EndDate.Set && !s.EndDate.Null {
+		endDate, err := ParseMonth(s.EndDate.Value)
+		if err != nil {
+			return nil, fmt.Errorf("subscription api: end_date: %w", err)
+		}
+		subscription.EndDate = &endDate
+	}
+
+	if s.TrialEndDate.Set && !s.TrialEndDate.Null {
+		trialEnd, err := ParseMonth(s.TrialEndDate.Value)
+		if err != nil {
+			return nil, fmt.Errorf("subscription api: trial_end_date: %w", err)
+		}
+		subscription.TrialEnd = &trialEnd
+	}
+
+	for _, p := range s.Pauses {
+		start, err := ParseMonth(p.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("subscription api: pauses: %w", err)
+		}
+		pause := Pause{Start: start}
+		if p.EndDate.Set && !p.EndDate.Null {
+			end, err := ParseMonth(p.EndDate.Value)
+			if err != nil {
+				return nil, fmt.Errorf("subscription api: pauses: %w", err)
+			}
+			pause.End = &end
+		}
+		subscription.Pauses = append(subscription.Pauses, pause)
+	}
+
+	for _, d := range s.Discounts {
+		start, err := ParseMonth(d.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("subscription api: discounts: %w", err)
+		}
+		discount := Discount{Kind: DiscountKind(d.Kind), Value: d.Value, Start: start}
+		if d.EndDate.Set && !d.EndDate.Null {
+			end, err := ParseMonth(d.EndDate.Value)
+			if err != nil {
+				return nil, fmt.Errorf("subscription api: discounts: %w", err)
+			}
+			discount.End = &end
+		}
+		subscription.Discounts = append(subscription.Discounts, discount)
+	}
+
+	for _, m := range s.Members {
+		subscription.Members = append(subscription.Members, Member{
+			UserID: m.UserID,
+			Share:  m.Share,
+			Amount: m.Amount,
+		})
+	}
+
+	return subscription, nil
+}
+
+func toSubscriptionPage(data []api.Subscription, pagination api.Pagination) (*SubscriptionPage, error) {
+	page := &SubscriptionPage{
+		Subscriptions: make([]Subscription, 0, len(data)),
+		Page:          pagination.Page.Value,
+		Limit:         pagination.Limit.Value,
+		Total:         pagination.Total.Value,
+		Pages:         pagination.Pages.Value,
+	}
+	for i := range data {
+		subscription, err := toSubscription(&data[i])
+		if err != nil {
+			return nil, err
+		}
+		page.Subscriptions = append(page.Subscriptions, *subscription)
+	}
+
+	return page, nil
+}
+
+func optMonth(t *time.Time) api.OptNilString {
+	if t == nil {
+		return api.OptNilString{}
+	}
+	return api.NewOptNilString(FormatMonth(*t))
+}
+
+func optPrice(price Money) api.OptMoney {
+	if price.Amount == 0 {
+		return api.OptMoney{}
+	}
+	return api.NewOptMoney(toMoneyInput(price))
+}
+
+func optInt(v int) api.OptInt {
+	if v == 0 {
+		return api.OptInt{}
+	}
+	return api.NewOptInt(v)
+}
+
+func optBool(v *bool) api.OptBool {
+	if v == nil {
+		return api.OptBool{}
+	}
+	return api.NewOptBool(*v)
+}
+
+func optString(s string) api.OptString {
+	if s == "" {
+		return api.OptString{}
+	}
+	return api.NewOptString(s)
+}
+
+func optSplit(rule SplitRule) api.OptSplitRule {
+	if rule == "" {
+		return api.OptSplitRule{}
+	}
+	return api.NewOptSplitRule(api.SplitRule(rule))
+}
+
+// toMemberInputs keeps nil members nil, so a patch leaves the members unchanged
+func toMemberInputs(members []Member) []api.SubscriptionMemberInput {
+	if members == nil {
+		return nil
+	}
+	result := make([]api.SubscriptionMemberInput, len(members))
+	for i, member := range members {
+		result[i] = api.SubscriptionMemberInput{UserID: member.UserID}
+		if member.Share != 0 {
+			result[i].Share = api.NewOptInt64(member.Share)
+		}
+	}
+	return result
+}
+
+// toDiscountInputs keeps nil discounts nil, so a patch leaves the discounts unchanged
+func toDiscountInputs(discounts []Discount) []api.SubscriptionDiscount {
+	if discounts == nil {
+		return nil
+	}
+	result := make([]api.SubscriptionDiscount, len(discounts))
+	for i, discount := range discounts {
+		result[i] = api.SubscriptionDiscount{
+			Kind:      api.SubscriptionDiscountKind(discount.Kind),
+			Value:     discount.Value,
+			StartDate: FormatMonth(discount.Start),
+			EndDate:   optMonth(discount.End),
+		}
+	}
+	return result
+}
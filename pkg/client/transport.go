@@ -0,0 +1,226 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	requestIDHeader      = "X-Request-ID"
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	// maxErrorBodyBytes limits how much of an error response is kept
+	maxErrorBodyBytes = 64 << 10
+)
+
+// RetryPolicy controls retries of requests rejected with 429 or failed with 5xx
+// or a network error. POST, PUT and PATCH are safe to retry because every call
+// carries an Idempotency-Key.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry, doubled for each next one
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. A Retry-After longer than MaxDelay is not waited for,
+	// the rate limit error is returned instead.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy retries up to three times with backoff from 200ms to 5s
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  200 * time.Millisecond,
+	MaxDelay:   5 * time.Second,
+}
+
+// NoRetry disables retries
+var NoRetry = RetryPolicy{}
+
+// backoff returns the delay before the given retry with full jitter
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay << retry
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(delay)) + 1)
+}
+
+type (
+	requestIDKey      struct{}
+	idempotencyKeyKey struct{}
+	exchangeKey       struct{}
+)
+
+// WithRequestID sets the X-Request-ID sent with requests made with ctx, so calls can be
+// correlated with the request being served. A random ID is generated per call otherwise.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID set with WithRequestID
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// WithIdempotencyKey sets the Idempotency-Key of the next mutating call made with ctx,
+// for callers that retry an operation themselves. A random key is generated per call otherwise.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// exchange records the final HTTP response of an API call
+type exchange struct {
+	requestID      string
+	idempotencyKey string
+
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// transport adds request headers, retries requests and keeps error responses
+// so they can be reported as APIError
+type transport struct {
+	next  http.RoundTripper
+	retry RetryPolicy
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	ex, _ := ctx.Value(exchangeKey{}).(*exchange)
+	if ex == nil {
+		ex = &exchange{requestID: newID(), idempotencyKey: newID()}
+	}
+
+	req = req.Clone(ctx)
+	req.Header.Set(requestIDHeader, ex.requestID)
+	if isMutating(req.Method) && req.Header.Get(idempotencyKeyHeader) == "" {
+		req.Header.Set(idempotencyKeyHeader, ex.idempotencyKey)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(req)
+
+		delay, retry := t.shouldRetry(attempt, resp, err)
+		if !retry {
+			if err != nil {
+				return nil, err
+			}
+			return ex.record(resp)
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodyBytes))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether the attempt should be retried and after which delay
+func (t *transport) shouldRetry(attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= t.retry.MaxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		return t.retry.backoff(attempt), true
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusServiceUnavailable:
+		delay := t.retry.backoff(attempt)
+		if wait := retryAfter(resp.Header); wait > 0 {
+			if wait > t.retry.MaxDelay {
+				return 0, false
+			}
+			delay = max(delay, wait)
+		}
+		return delay, true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return t.retry.backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+// record keeps the status and, for errors, the body of the final response.
+// The body is replaced so the generated client can still decode it.
+func (ex *exchange) record(resp *http.Response) (*http.Response, error) {
+	ex.statusCode = resp.StatusCode
+	ex.header = resp.Header
+
+	if resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	ex.body = body
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date
+func retryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	default:
+		return false
+	}
+}
+
+func newID() string {
+	return uuid.NewString()
+}